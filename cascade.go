@@ -0,0 +1,563 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// CascadeLevel is a column of a Cascade prompt.
+type CascadeLevel struct {
+	// Name is shown above the column
+	Name string
+	// Options returns the options of the level for the values chosen in the levels to its left.
+	// The cascade ends at the previous level when it returns no options.
+	Options func(path []string) []string
+}
+
+// CascadeCell is an option of a Cascade the way it is shown by the template
+type CascadeCell struct {
+	// Value is padded to the width of its column
+	Value string
+	// Chosen is set for the option chosen in every column
+	Chosen bool
+	// Focused is set for the chosen option of the column the user is in
+	Focused bool
+}
+
+/*
+Cascade is a prompt that presents several select columns side by side, where the options of every
+column depend on the options chosen in the columns to its left. The up and down arrows change the
+option of the current column and the left and right arrows move between the columns. Response type
+is a core.TreeAnswer with the last value and the path of the values chosen in every column.
+Default is that path.
+
+	location := []string{}
+	prompt := &survey.Cascade{
+		Message: "Choose a zone:",
+		Levels: []survey.CascadeLevel{
+			{Name: "Country", Options: func([]string) []string { return countries }},
+			{Name: "Region", Options: func(path []string) []string { return regions[path[0]] }},
+			{Name: "Zone", Options: func(path []string) []string { return zones[path[1]] }},
+		},
+	}
+	survey.AskOne(prompt, &location)
+*/
+type Cascade struct {
+	Renderer
+	Message       string
+	Levels        []CascadeLevel
+	Default       []string
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	Filter        func(filter string, value string, index int) bool
+	columns       []cascadeColumn
+	level         int
+	filter        string
+	showingHelp   bool
+}
+
+// cascadeColumn holds the options loaded for a level and which of them is chosen
+type cascadeColumn struct {
+	options []string
+	chosen  int
+}
+
+// CascadeTemplateData is the data available to the templates when processing
+type CascadeTemplateData struct {
+	Cascade
+	Header      string
+	PageEntries [][]CascadeCell
+	Answer      string
+	ShowAnswer  bool
+	ShowHelp    bool
+	Config      *PromptConfig
+}
+
+var CascadeQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, left and right to change the level, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- if .Header}}{{color "default+hb"}}{{ .Header }}{{color "reset"}}{{"\n"}}{{end}}
+  {{- range $row := .PageEntries}}
+    {{- range $ix, $cell := $row}}
+      {{- if $ix}}{{"  "}}{{end}}
+      {{- if $cell.Focused }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }} {{else if $cell.Chosen}}{{color "cyan"}}› {{else}}{{color "default"}}  {{end}}
+      {{- $cell.Value}}
+      {{- color "reset"}}
+    {{- end}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// match returns the filter used to find the options the user is looking for
+func (c *Cascade) match(config *PromptConfig) func(filter string, value string, index int) bool {
+	if c.Filter != nil {
+		return c.Filter
+	}
+	return config.Filter
+}
+
+// levelName returns how a level is called in errors
+func (c *Cascade) levelName(level int) string {
+	if c.Levels[level].Name != "" {
+		return strings.ToLower(c.Levels[level].Name)
+	}
+	return fmt.Sprintf("level %d", level+1)
+}
+
+// path returns the values chosen in the given number of columns
+func (c *Cascade) path(columns int) []string {
+	path := []string{}
+	for _, column := range c.columns[:columns] {
+		path = append(path, column.options[column.chosen])
+	}
+	return path
+}
+
+// load asks the levels to the right of the given one for their options, choosing their first
+// option, until a level has no options for the values chosen to its left
+func (c *Cascade) load(level int) {
+	c.columns = c.columns[:level+1]
+	for l := level + 1; l < len(c.Levels); l++ {
+		options := c.Levels[l].Options(c.path(l))
+		if len(options) == 0 {
+			break
+		}
+		c.columns = append(c.columns, cascadeColumn{options: options})
+	}
+}
+
+// choose loads the levels and chooses the values of the given path
+func (c *Cascade) choose(path []string) error {
+	if len(c.Levels) == 0 {
+		return errors.New("please provide levels to select from")
+	}
+
+	c.columns = nil
+	for l := range c.Levels {
+		options := c.Levels[l].Options(c.path(l))
+		if len(options) == 0 {
+			if l == 0 {
+				return errors.New("please provide options to select from")
+			}
+			break
+		}
+
+		column := cascadeColumn{options: options}
+		if l < len(path) {
+			found := false
+			for i, opt := range options {
+				if opt == path[l] {
+					column.chosen = i
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("%q is not an option of the %s", path[l], c.levelName(l))
+			}
+		}
+		c.columns = append(c.columns, column)
+	}
+
+	if len(path) > len(c.columns) {
+		return fmt.Errorf("%q has nothing to choose after it", strings.Join(path[:len(c.columns)], "/"))
+	}
+	return nil
+}
+
+// answer returns the path of the values chosen in every column
+func (c *Cascade) answer() core.TreeAnswer {
+	last := c.columns[len(c.columns)-1]
+	return core.TreeAnswer{
+		Value: last.options[last.chosen],
+		Path:  c.path(len(c.columns)),
+		Index: last.chosen,
+	}
+}
+
+// visible returns the indices of the options of the current column that match the filter
+func (c *Cascade) visible(config *PromptConfig) []int {
+	visible := []int{}
+	for i, opt := range c.columns[c.level].options {
+		if c.filter == "" || c.match(config)(c.filter, opt, i) {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
+
+// move chooses the visible option the given number of options away in the current column,
+// wrapping around at the ends. A delta of 0 makes sure the chosen option is a visible one.
+func (c *Cascade) move(visible []int, delta int) {
+	if len(visible) == 0 {
+		return
+	}
+	column := &c.columns[c.level]
+
+	pos := -1
+	for p, i := range visible {
+		if i == column.chosen {
+			pos = p
+		}
+	}
+	if pos == -1 {
+		pos = 0
+	} else if delta == 0 {
+		return
+	} else {
+		pos = (pos + delta + len(visible)) % len(visible)
+	}
+
+	column.chosen = visible[pos]
+	c.load(c.level)
+}
+
+// OnChange is called on every keypress.
+func (c *Cascade) OnChange(key rune, config *PromptConfig) bool {
+	visible := c.visible(config)
+
+	if key == terminal.KeyEnter || key == '\n' {
+		// we're done if there is an option to pick
+		return len(visible) > 0
+	} else if key == terminal.KeyArrowUp || (c.VimMode && key == 'k') {
+		c.move(visible, -1)
+	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (c.VimMode && key == 'j') {
+		c.move(visible, 1)
+	} else if key == terminal.KeyArrowRight || (c.VimMode && key == 'l') {
+		if c.level < len(c.columns)-1 && len(visible) > 0 {
+			c.level++
+			c.filter = ""
+		}
+	} else if key == terminal.KeyArrowLeft || (c.VimMode && key == 'h') {
+		if c.level > 0 {
+			c.level--
+			c.filter = ""
+		}
+	} else if string(key) == config.HelpInput && c.Help != "" {
+		c.showingHelp = true
+	} else if key == terminal.KeyEscape {
+		c.VimMode = !c.VimMode
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		c.filter = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if c.filter != "" {
+			runeFilter := []rune(c.filter)
+			c.filter = string(runeFilter[0 : len(runeFilter)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		c.filter += string(key)
+		c.VimMode = false
+	}
+
+	// the chosen option has to be one of the ones the user can see
+	c.move(c.visible(config), 0)
+
+	c.FilterMessage = ""
+	if c.filter != "" {
+		c.FilterMessage = " " + c.filter
+	}
+
+	_ = c.render(config)
+
+	// keep prompting
+	return false
+}
+
+// render shows the columns side by side, dropping the ones on the left that don't fit
+func (c *Cascade) render(config *PromptConfig) error {
+	pageSize := c.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	// every column is as wide as its name and its options, plus the marker and the gap
+	widths := []int{}
+	for l, column := range c.columns {
+		width := terminal.StringWidth(c.Levels[l].Name)
+		for _, opt := range column.options {
+			if w := terminal.StringWidth(opt); w > width {
+				width = w
+			}
+		}
+		widths = append(widths, width)
+	}
+	first := 0
+	for first < c.level {
+		total := 0
+		for _, width := range widths[first:] {
+			total += width + 4
+		}
+		if total <= c.termWidthSafe() {
+			break
+		}
+		first++
+	}
+
+	pages := [][]CascadeCell{}
+	rows := 0
+	for l := first; l < len(c.columns); l++ {
+		column := c.columns[l]
+
+		choices := []core.OptionAnswer{}
+		chosen := 0
+		for _, i := range c.visibleIn(l, config) {
+			if i == column.chosen {
+				chosen = len(choices)
+			}
+			choices = append(choices, core.OptionAnswer{Value: column.options[i], Index: i})
+		}
+		opts, _ := paginate(pageSize, choices, chosen)
+
+		page := []CascadeCell{}
+		for _, opt := range opts {
+			page = append(page, CascadeCell{
+				Value:   c.pad(opt.Value, widths[l], l),
+				Chosen:  opt.Index == column.chosen,
+				Focused: opt.Index == column.chosen && l == c.level,
+			})
+		}
+		pages = append(pages, page)
+		if len(page) > rows {
+			rows = len(page)
+		}
+	}
+
+	// the options are listed by column but shown by row
+	entries := [][]CascadeCell{}
+	for r := 0; r < rows; r++ {
+		row := []CascadeCell{}
+		for p, page := range pages {
+			if r < len(page) {
+				row = append(row, page[r])
+			} else {
+				row = append(row, CascadeCell{Value: c.pad("", widths[first+p], first+p)})
+			}
+		}
+		entries = append(entries, row)
+	}
+
+	// the names are only shown when there is one
+	header := ""
+	named := false
+	names := []string{}
+	for l := first; l < len(c.columns); l++ {
+		named = named || c.Levels[l].Name != ""
+		names = append(names, "  "+c.pad(c.Levels[l].Name, widths[l], l))
+	}
+	if named {
+		header = strings.TrimRight(strings.Join(names, "  "), " ")
+	}
+
+	return c.Render(CascadeQuestionTemplate, CascadeTemplateData{
+		Cascade:     *c,
+		Header:      header,
+		PageEntries: entries,
+		ShowHelp:    c.showingHelp,
+		Config:      config,
+	})
+}
+
+// visibleIn returns the options shown in a column, only the current column is filtered
+func (c *Cascade) visibleIn(level int, config *PromptConfig) []int {
+	if level == c.level {
+		return c.visible(config)
+	}
+	visible := []int{}
+	for i := range c.columns[level].options {
+		visible = append(visible, i)
+	}
+	return visible
+}
+
+// pad fills the text to the width of its column, except in the last column
+func (c *Cascade) pad(text string, width int, level int) string {
+	if level == len(c.columns)-1 {
+		return text
+	}
+	return text + strings.Repeat(" ", width-terminal.StringWidth(text))
+}
+
+func (c *Cascade) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := c.choose(c.Default); err != nil {
+		if len(c.Default) > 0 {
+			return core.TreeAnswer{}, fmt.Errorf("default value %w", err)
+		}
+		return core.TreeAnswer{}, err
+	}
+	// start in the last column of the default
+	c.level = 0
+	if len(c.Default) > 0 {
+		c.level = len(c.Default) - 1
+	}
+
+	if !c.interactive() {
+		return c.promptPlain(config)
+	}
+
+	cursor := c.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := c.render(config); err != nil {
+		return core.TreeAnswer{}, err
+	}
+
+	rr := c.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return core.TreeAnswer{}, err
+		}
+		if r == terminal.KeyInterrupt {
+			return core.TreeAnswer{}, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			c.filter = ""
+			c.FilterMessage = ""
+			return core.TreeAnswer{}, ErrGoBack
+		}
+		if r == terminal.KeyEndTransmission {
+			break
+		}
+		if c.OnChange(r, config) {
+			break
+		}
+	}
+	c.filter = ""
+	c.FilterMessage = ""
+
+	return c.answer(), nil
+}
+
+// promptPlain asks for one level at a time, listing the numbered options of the level and reading
+// a line with the number or the value of one of them.
+func (c *Cascade) promptPlain(config *PromptConfig) (interface{}, error) {
+	for level := 0; level < len(c.columns); level++ {
+		column := &c.columns[level]
+
+		// remind the user of what they chose so far
+		message := c.Message
+		if level > 0 {
+			message += " " + strings.Join(c.path(level), "/")
+		}
+
+		_, err := c.askPlain(config, PlainTemplateData{
+			Message:      message,
+			Help:         c.Help,
+			Options:      plainOptions(column.options),
+			Instructions: "Enter a number or value",
+			Default:      column.options[column.chosen],
+		}, func(line string) (interface{}, error) {
+			if strings.TrimSpace(line) == "" {
+				return nil, nil
+			}
+
+			opt, err := parsePlainOption(column.options, line)
+			if err != nil {
+				return nil, err
+			}
+			if opt.Index != column.chosen {
+				column.chosen = opt.Index
+				c.load(level)
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return core.TreeAnswer{}, err
+		}
+	}
+
+	return c.answer(), nil
+}
+
+// parseAnswer chooses the path given by an AnswerSource level by level.
+func (c *Cascade) parseAnswer(value interface{}) (interface{}, error) {
+	path, err := cascadePath(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.choose(path); err != nil {
+		return nil, err
+	}
+	return c.answer(), nil
+}
+
+// defaultAnswer returns the answer with the default path, or the first option of every level
+// after it.
+func (c *Cascade) defaultAnswer() (interface{}, error) {
+	if err := c.choose(c.Default); err != nil {
+		return nil, err
+	}
+	return c.answer(), nil
+}
+
+// SetDefault uses the given path as the default selection. It accepts a TreeAnswer, a slice of
+// values or a string with the values separated by slashes.
+func (c *Cascade) SetDefault(value interface{}) error {
+	path, err := cascadePath(value)
+	if err != nil {
+		return err
+	}
+
+	// make sure the path can be chosen without changing the prompt
+	check := &Cascade{Levels: c.Levels}
+	if err := check.choose(path); err != nil {
+		return err
+	}
+	c.Default = path
+	return nil
+}
+
+// cascadePath returns the path described by a default or an answer
+func cascadePath(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case core.TreeAnswer:
+		return v.Path, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		path := []string{}
+		for _, part := range v {
+			path = append(path, fmt.Sprint(part))
+		}
+		return path, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return strings.Split(v, "/"), nil
+	}
+	return nil, fmt.Errorf("cannot use %T as the path of a cascade", value)
+}
+
+func (c *Cascade) Cleanup(config *PromptConfig, val interface{}) error {
+	if !c.interactive() {
+		return nil
+	}
+	return c.Render(
+		CascadeQuestionTemplate,
+		CascadeTemplateData{
+			Cascade:    *c,
+			Answer:     val.(core.TreeAnswer).String(),
+			ShowAnswer: true,
+			Config:     config,
+		},
+	)
+}
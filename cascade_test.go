@@ -0,0 +1,257 @@
+package survey
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// locationLevels describe where to find a zone, Monaco has no regions
+func locationLevels() []CascadeLevel {
+	regions := map[string][]string{
+		"France":  {"Brittany", "Normandy"},
+		"Germany": {"Bavaria", "Berlin", "Hesse"},
+	}
+	zones := map[string][]string{
+		"Brittany": {"brest-a"},
+		"Normandy": {"caen-a", "caen-b"},
+		"Bavaria":  {"munich-a", "munich-b"},
+		"Berlin":   {"berlin-a"},
+		"Hesse":    {"frankfurt-a", "frankfurt-b", "frankfurt-c"},
+	}
+
+	return []CascadeLevel{
+		{Name: "Country", Options: func([]string) []string { return []string{"France", "Germany", "Monaco"} }},
+		{Name: "Region", Options: func(path []string) []string { return regions[path[0]] }},
+		{Name: "Zone", Options: func(path []string) []string { return zones[path[1]] }},
+	}
+}
+
+func TestCascadeRender(t *testing.T) {
+	prompt := Cascade{
+		Message: "Choose a zone:",
+		Levels:  locationLevels(),
+	}
+
+	helpfulPrompt := prompt
+	helpfulPrompt.Help = "This is helpful"
+
+	tests := []struct {
+		title    string
+		prompt   Cascade
+		data     CascadeTemplateData
+		expected string
+	}{
+		{
+			"Test Cascade question output",
+			prompt,
+			CascadeTemplateData{
+				Header: "  Country    Region      Zone",
+				PageEntries: [][]CascadeCell{
+					{{Value: "France "}, {Value: "Bavaria ", Chosen: true}, {Value: "munich-a"}},
+					{{Value: "Germany", Chosen: true}, {Value: "Berlin  "}, {Value: "munich-b", Chosen: true, Focused: true}},
+					{{Value: "Monaco "}, {Value: "Hesse   "}, {Value: ""}},
+				},
+			},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Choose a zone:  [Use arrows to move, left and right to change the level, type to filter]", defaultIcons().Question.Text),
+					"  Country    Region      Zone",
+					"  France   › Bavaria     munich-a",
+					fmt.Sprintf("› Germany    Berlin    %s munich-b", defaultIcons().SelectFocus.Text),
+					"  Monaco     Hesse       \n",
+				},
+				"\n",
+			),
+		},
+		{
+			"Test Cascade answer output",
+			prompt,
+			CascadeTemplateData{Answer: "Germany/Bavaria/munich-b", ShowAnswer: true},
+			fmt.Sprintf("%s Choose a zone: Germany/Bavaria/munich-b\n", defaultIcons().Question.Text),
+		},
+		{
+			"Test Cascade question output with help hidden",
+			helpfulPrompt,
+			CascadeTemplateData{},
+			fmt.Sprintf("%s Choose a zone:  [Use arrows to move, left and right to change the level, type to filter, %s for more help]\n", defaultIcons().Question.Text, defaultPromptConfig().HelpInput),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.data.Cascade = test.prompt
+
+			// set the icon set
+			test.data.Config = defaultPromptConfig()
+
+			err = test.prompt.Render(
+				CascadeQuestionTemplate,
+				test.data,
+			)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestCascadePrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"basic interaction",
+			&Cascade{
+				Message: "Choose a zone:",
+				Levels:  locationLevels(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a zone:")
+				c.Send(string(terminal.KeyArrowDown))
+				c.ExpectString("Bavaria")
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "munich-b", Path: []string{"Germany", "Bavaria", "munich-b"}, Index: 1},
+		},
+		{
+			"going back changes the levels to the right",
+			&Cascade{
+				Message: "Choose a zone:",
+				Levels:  locationLevels(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a zone:")
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowLeft))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "munich-a", Path: []string{"Germany", "Bavaria", "munich-a"}, Index: 0},
+		},
+		{
+			"level without options",
+			&Cascade{
+				Message: "Choose a zone:",
+				Levels:  locationLevels(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a zone:")
+				c.Send(string(terminal.KeyArrowUp))
+				// there is nowhere to go from Monaco
+				c.Send(string(terminal.KeyArrowRight))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "Monaco", Path: []string{"Monaco"}, Index: 2},
+		},
+		{
+			"filter the current level",
+			&Cascade{
+				Message: "Choose a zone:",
+				Levels:  locationLevels(),
+				Default: []string{"Germany", "Hesse"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a zone:")
+				c.Send("ber")
+				c.Send(string(terminal.KeyArrowRight))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "berlin-a", Path: []string{"Germany", "Berlin", "berlin-a"}, Index: 0},
+		},
+		{
+			"default",
+			&Cascade{
+				Message: "Choose a zone:",
+				Levels:  locationLevels(),
+				Default: []string{"France", "Normandy", "caen-b"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a zone:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "caen-b", Path: []string{"France", "Normandy", "caen-b"}, Index: 1},
+		},
+		{
+			"prompt for help",
+			&Cascade{
+				Message: "Choose a zone:",
+				Levels:  locationLevels(),
+				Help:    "Where the servers are",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a zone:")
+				c.Send("?")
+				c.ExpectString("Where the servers are")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "brest-a", Path: []string{"France", "Brittany", "brest-a"}, Index: 0},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestCascadeSetDefault(t *testing.T) {
+	prompt := &Cascade{Levels: locationLevels()}
+
+	assert.NoError(t, prompt.SetDefault("Germany/Hesse"))
+	assert.Equal(t, []string{"Germany", "Hesse"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault(core.TreeAnswer{Path: []string{"Monaco"}}))
+	assert.Equal(t, []string{"Monaco"}, prompt.Default)
+
+	assert.EqualError(t, prompt.SetDefault([]string{"Germany", "Brittany"}), `"Brittany" is not an option of the region`)
+	assert.EqualError(t, prompt.SetDefault("Monaco/Monte Carlo"), `"Monaco" has nothing to choose after it`)
+	assert.Error(t, prompt.SetDefault(3))
+	assert.Equal(t, []string{"Monaco"}, prompt.Default)
+}
+
+func TestCascade_WriteAnswer(t *testing.T) {
+	answers := struct {
+		Zone string
+		Path []string
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "zone", Prompt: &Cascade{Levels: locationLevels()}},
+		{Name: "path", Prompt: &Cascade{Levels: locationLevels()}},
+	}, &answers, WithAnswerSource(MapSource{
+		"zone": "Germany/Hesse/frankfurt-c",
+		"path": []interface{}{"France", "Normandy"},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "frankfurt-c", answers.Zone)
+	assert.Equal(t, []string{"France", "Normandy", "caen-a"}, answers.Path)
+}
@@ -3,6 +3,7 @@ package survey
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 )
 
 // Confirm is a regular text input that accept yes/no answers. Response type is a bool.
@@ -56,7 +57,7 @@ func (c *Confirm) getBool(showHelp bool, config *PromptConfig) (bool, error) {
 
 	// start waiting for input
 	for {
-		line, err := rr.ReadLine(0)
+		line, err := rr.ReadLine(0, goBackOnRune(config))
 		if err != nil {
 			return false, err
 		}
@@ -121,6 +122,10 @@ by a carriage return.
 	survey.AskOne(prompt, &likesPie)
 */
 func (c *Confirm) Prompt(config *PromptConfig) (interface{}, error) {
+	if !c.interactive() {
+		return c.promptPlain(config)
+	}
+
 	// render the question template
 	err := c.Render(
 		ConfirmQuestionTemplate,
@@ -137,8 +142,69 @@ func (c *Confirm) Prompt(config *PromptConfig) (interface{}, error) {
 	return c.getBool(false, config)
 }
 
+// promptPlain reads a yes or a no, with an empty line standing for the default.
+func (c *Confirm) promptPlain(config *PromptConfig) (interface{}, error) {
+	hint := "y/N"
+	if c.Default {
+		hint = "Y/n"
+	}
+	return c.askPlain(config, PlainTemplateData{
+		Message: c.Message,
+		Help:    c.Help,
+		Default: hint,
+	}, func(val string) (interface{}, error) {
+		switch {
+		case yesRx.MatchString(val):
+			return true, nil
+		case noRx.MatchString(val):
+			return false, nil
+		case val == "":
+			return c.Default, nil
+		}
+		//lint:ignore ST1005 it should be fine for this error message to have punctuation
+		return nil, fmt.Errorf("%q is not a valid answer, please try again.", val)
+	})
+}
+
+// parseAnswer accepts a bool from an AnswerSource, or a string with either the answer the user
+// would type or one that strconv.ParseBool understands.
+func (c *Confirm) parseAnswer(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch {
+		case yesRx.MatchString(v):
+			return true, nil
+		case noRx.MatchString(v):
+			return false, nil
+		}
+		return strconv.ParseBool(v)
+	}
+	return nil, fmt.Errorf("cannot use %T as the answer to a confirm", value)
+}
+
+// defaultAnswer returns the answer given by pressing enter straight away.
+func (c *Confirm) defaultAnswer() (interface{}, error) {
+	return c.Default, nil
+}
+
+// SetDefault uses the given bool as the default answer.
+func (c *Confirm) SetDefault(value interface{}) error {
+	b, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("cannot use %T as the default of a confirm", value)
+	}
+	c.Default = b
+	return nil
+}
+
 // Cleanup overwrite the line with the finalized formatted version
 func (c *Confirm) Cleanup(config *PromptConfig, val interface{}) error {
+	if !c.interactive() {
+		return nil
+	}
+
 	// if the value was previously true
 	ans := yesNo(val.(bool))
 
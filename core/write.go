@@ -3,6 +3,7 @@ package core
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -12,18 +13,46 @@ import (
 // the tag used to denote the name of the question
 const tagName = "survey"
 
+// the type of the time answers are written to
+var timeType = reflect.TypeOf(time.Time{})
+
 // Settable allow for configuration when assigning answers
 type Settable interface {
 	WriteAnswer(field string, value interface{}) error
 }
 
 // OptionAnswer is the return type of Selects/MultiSelects that lets the appropriate information
-// get copied to the user's struct
+// get copied to the user's struct. When Data is set it is written in place of the Value and the
+// Index, so an option can answer with an int, a custom type or a struct.
 type OptionAnswer struct {
 	Value string
 	Index int
+	Data  interface{}
+}
+
+// OtherIndex is the Index of the OptionAnswer for a value the user typed themselves instead of
+// picking one of the options
+const OtherIndex = -1
+
+// TreeAnswer is the return type of TreeSelects/MultiTreeSelects. Path holds the values of the
+// node and all of its ancestors, starting from the root, and Index is the position of the node
+// when every node of the tree is listed depth first. Cascades answer with a TreeAnswer too, where
+// Path holds the value chosen in every level and Index is the position of the last one in its
+// level.
+type TreeAnswer struct {
+	Value string
+	Path  []string
+	Index int
+}
+
+// String returns the path of the node separated by slashes
+func (t TreeAnswer) String() string {
+	return strings.Join(t.Path, "/")
 }
 
+// the type of the tree answers
+var treeAnswerType = reflect.TypeOf(TreeAnswer{})
+
 type reflectField struct {
 	value     reflect.Value
 	fieldType reflect.StructField
@@ -66,6 +95,14 @@ func WriteAnswer(t interface{}, name string, v interface{}) (err error) {
 			// copy the value over to the normal struct
 			return copy(elem, value)
 		}
+		// the same goes for a struct that holds the data of an option answer
+		if ans, ok := v.(OptionAnswer); ok && ans.Data != nil && reflect.TypeOf(ans.Data).AssignableTo(elem.Type()) {
+			return copy(elem, value)
+		}
+		// the same goes for times and tree answers
+		if elem.Type() == timeType || elem.Type() == treeAnswerType {
+			return copy(elem, value)
+		}
 
 		// get the name of the field that matches the string we  were given
 		field, _, err := findField(elem, name)
@@ -89,36 +126,130 @@ func WriteAnswer(t interface{}, name string, v interface{}) (err error) {
 		// copy the value over to the normal struct
 		return copy(field, value)
 	case reflect.Map:
-		mapType := reflect.TypeOf(t).Elem()
+		// a map answer given without a name fills the whole map
+		if name == "" && value.Kind() == reflect.Map {
+			return copy(elem, value)
+		}
+
+		mapType := elem.Type()
 		if mapType.Key().Kind() != reflect.String {
 			return errors.New("answer maps key must be of type string")
 		}
 
-		// copy only string value/index value to map if,
-		// map is not of type interface and is 'OptionAnswer'
-		if value.Type().Name() == "OptionAnswer" {
-			if kval := mapType.Elem().Kind(); kval == reflect.String {
-				mt := *t.(*map[string]string)
-				mt[name] = value.FieldByName("Value").String()
-				return nil
-			} else if kval == reflect.Int {
-				mt := *t.(*map[string]int)
-				mt[name] = int(value.FieldByName("Index").Int())
-				return nil
-			}
+		// convert the answer to the type of the values of the map, for example the Value of
+		// an OptionAnswer is written to a map[string]string and its Index to a map[string]int
+		item := reflect.New(mapType.Elem()).Elem()
+		if err := copy(item, value); err != nil {
+			return err
 		}
 
-		if mapType.Elem().Kind() != reflect.Interface {
-			return errors.New("answer maps must be of type map[string]interface")
+		if elem.IsNil() {
+			elem.Set(reflect.MakeMap(mapType))
 		}
-		mt := *t.(*map[string]interface{})
-		mt[name] = value.Interface()
+		elem.SetMapIndex(reflect.ValueOf(name).Convert(mapType.Key()), item)
 		return nil
 	}
 	// otherwise just copy the value to the target
 	return copy(elem, value)
 }
 
+// ReadAnswer is the inverse of WriteAnswer: it returns the value currently stored in the field or
+// map key that WriteAnswer would write the answer to the question with the given name to.
+func ReadAnswer(t interface{}, name string) (interface{}, error) {
+	// the target to read from
+	target := reflect.ValueOf(t)
+
+	// make sure we are reading from a pointer
+	if target.Kind() != reflect.Ptr {
+		return nil, errors.New("you must pass a pointer as the target of a Read operation")
+	}
+	// the object "inside" of the target pointer
+	elem := target.Elem()
+
+	switch elem.Kind() {
+	case reflect.Struct:
+		// an option answer, a time or a tree answer holds a single answer
+		if elem.Type().Name() == "OptionAnswer" || elem.Type() == timeType || elem.Type() == treeAnswerType {
+			return elem.Interface(), nil
+		}
+
+		field, _, err := findField(elem, name)
+		if err != nil {
+			return nil, err
+		}
+		return field.Interface(), nil
+	case reflect.Map:
+		// the whole map holds the answer when it's read without a name
+		if name == "" {
+			return elem.Interface(), nil
+		}
+		if elem.Type().Key().Kind() != reflect.String {
+			return nil, errors.New("answer maps key must be of type string")
+		}
+
+		value := elem.MapIndex(reflect.ValueOf(name).Convert(elem.Type().Key()))
+		if !value.IsValid() {
+			return nil, errFieldNotMatch{name}
+		}
+		return value.Interface(), nil
+	}
+	// otherwise the target holds the answer itself
+	return elem.Interface(), nil
+}
+
+// ResetAnswer undoes WriteAnswer: it sets the field that the answer to the question with the
+// given name was written to back to its zero value, or removes the key from a map. Targets that
+// implement Settable are left untouched since only they know how they store their answers.
+func ResetAnswer(t interface{}, name string) error {
+	if _, ok := t.(Settable); ok {
+		return nil
+	}
+
+	// the target to reset
+	target := reflect.ValueOf(t)
+
+	// make sure we are writing to a pointer
+	if target.Kind() != reflect.Ptr {
+		return errors.New("you must pass a pointer as the target of a Reset operation")
+	}
+	// the object "inside" of the target pointer
+	elem := target.Elem()
+
+	switch elem.Kind() {
+	case reflect.Struct:
+		// an option answer, a time or a tree answer holds a single answer
+		if elem.Type().Name() == "OptionAnswer" || elem.Type() == timeType || elem.Type() == treeAnswerType {
+			break
+		}
+
+		field, _, err := findField(elem, name)
+		if err != nil {
+			return err
+		}
+		if _, ok := field.Interface().(Settable); ok {
+			return nil
+		}
+		if field.CanAddr() {
+			if _, ok := field.Addr().Interface().(Settable); ok {
+				return nil
+			}
+		}
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	case reflect.Map:
+		if elem.Type().Key().Kind() != reflect.String {
+			return errors.New("answer maps key must be of type string")
+		}
+		if !elem.IsNil() {
+			elem.SetMapIndex(reflect.ValueOf(name).Convert(elem.Type().Key()), reflect.Value{})
+		}
+		return nil
+	}
+	// otherwise the target holds the answer itself
+	elem.Set(reflect.Zero(elem.Type()))
+	return nil
+}
+
 type errFieldNotMatch struct {
 	questionName string
 }
@@ -205,6 +336,43 @@ func flattenFields(s reflect.Value) []reflectField {
 	return fields
 }
 
+// StructField is a field of a struct that an answer can be written to
+type StructField struct {
+	// Name is the name of the question that writes to the field, taken from the survey tag
+	// or the name of the field if there is no tag
+	Name  string
+	Field reflect.StructField
+	Value reflect.Value
+}
+
+// StructFields returns the exported fields of the struct that t points to, including the fields
+// of embedded structs. Fields tagged with `survey:"-"` are left out.
+func StructFields(t interface{}) ([]StructField, error) {
+	target := reflect.ValueOf(t)
+	if target.Kind() != reflect.Ptr || target.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("you must pass a pointer to a struct")
+	}
+
+	fields := []StructField{}
+	for _, f := range flattenFields(target.Elem()) {
+		// skip unexported fields since we can't write to them
+		if f.fieldType.PkgPath != "" {
+			continue
+		}
+
+		name := f.fieldType.Tag.Get(tagName)
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.fieldType.Name
+		}
+
+		fields = append(fields, StructField{Name: name, Field: f.fieldType, Value: f.value})
+	}
+	return fields, nil
+}
+
 // isList returns true if the element is something we can Len()
 func isList(v reflect.Value) bool {
 	switch v.Type().Kind() {
@@ -215,6 +383,30 @@ func isList(v reflect.Value) bool {
 	}
 }
 
+// isNumber returns true if the element is an int, uint or float
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNegative returns true if the number is less than zero
+func isNegative(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
 // Write takes a value and copies it to the target
 func copy(t reflect.Value, v reflect.Value) (err error) {
 	// if something ends up panicing we need to catch it in a deferred func
@@ -231,6 +423,22 @@ func copy(t reflect.Value, v reflect.Value) (err error) {
 		}
 	}()
 
+	// if we are copying a value to a pointer, point to a copy of the value
+	if t.Kind() == reflect.Ptr && v.Kind() != reflect.Ptr {
+		ptr := reflect.New(t.Type().Elem())
+		if err := copy(ptr.Elem(), v); err != nil {
+			return err
+		}
+		t.Set(ptr)
+		return
+	}
+
+	// an interface can hold the value as it is
+	if t.Kind() == reflect.Interface && v.Type().AssignableTo(t.Type()) {
+		t.Set(v)
+		return
+	}
+
 	// if we are copying from a string result to something else
 	if v.Kind() == reflect.String && v.Type() != t.Type() {
 		var castVal interface{}
@@ -300,6 +508,12 @@ func copy(t reflect.Value, v reflect.Value) (err error) {
 			}
 		case reflect.Float64:
 			castVal, casterr = strconv.ParseFloat(vString, 64)
+		case reflect.Struct:
+			if t.Type() != timeType {
+				//lint:ignore ST1005 allow this error message to be capitalized
+				return fmt.Errorf("Unable to convert from string to type %s", t.Type())
+			}
+			castVal, casterr = time.Parse(time.RFC3339, vString)
 		default:
 			//lint:ignore ST1005 allow this error message to be capitalized
 			return fmt.Errorf("Unable to convert from string to type %s", t.Kind())
@@ -313,8 +527,37 @@ func copy(t reflect.Value, v reflect.Value) (err error) {
 		return
 	}
 
+	// if we are copying a number to a different kind of number
+	if isNumber(v) && isNumber(t) && v.Type() != t.Type() {
+		converted := v.Convert(t.Type())
+
+		// make sure the number fits in the target, floats are allowed to lose some precision
+		lost := converted.Convert(v.Type()).Interface() != v.Interface()
+		if t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64 {
+			lost = math.IsInf(converted.Float(), 0)
+		}
+		if lost || isNegative(v) != isNegative(converted) {
+			//lint:ignore ST1005 allow this error message to be capitalized
+			return fmt.Errorf("Unable to store %v in type %s", v.Interface(), t.Type())
+		}
+
+		t.Set(converted)
+		return
+	}
+
 	// if we are copying from an OptionAnswer to something
 	if v.Type().Name() == "OptionAnswer" {
+		// copying an OptionAnswer to an OptionAnswer
+		if t.Type().Name() == "OptionAnswer" {
+			t.Set(v)
+			return
+		}
+
+		// copying the data of the option instead of its value or index
+		if data := v.FieldByName("Data"); !data.IsNil() {
+			return copy(t, data.Elem())
+		}
+
 		// copying an option answer to a string
 		if t.Kind() == reflect.String {
 			// copies the Value field of the struct
@@ -329,17 +572,61 @@ func copy(t reflect.Value, v reflect.Value) (err error) {
 			return
 		}
 
-		// copying an OptionAnswer to an OptionAnswer
-		if t.Type().Name() == "OptionAnswer" {
-			t.Set(v)
-			return
-		}
-
 		// we're copying an option answer to an incorrect type
 		//lint:ignore ST1005 allow this error message to be capitalized
 		return fmt.Errorf("Unable to convert from OptionAnswer to type %s", t.Kind())
 	}
 
+	// if we are copying from a TreeAnswer to something
+	if v.Type() == treeAnswerType {
+		answer := v.Interface().(TreeAnswer)
+		switch {
+		case v.Type().AssignableTo(t.Type()):
+			t.Set(v)
+		case t.Kind() == reflect.String:
+			// copies the value of the node
+			t.SetString(answer.Value)
+		case isList(t):
+			// copies the path of the node
+			return copy(t, reflect.ValueOf(answer.Path))
+		default:
+			//lint:ignore ST1005 allow this error message to be capitalized
+			return fmt.Errorf("Unable to convert from TreeAnswer to type %s", t.Kind())
+		}
+		return
+	}
+
+	// if we are copying from a map of answers to a struct
+	if v.Kind() == reflect.Map && t.Kind() == reflect.Struct {
+		// write every answer to the matching field
+		for _, key := range v.MapKeys() {
+			if err := WriteAnswer(t.Addr().Interface(), key.String(), v.MapIndex(key).Interface()); err != nil {
+				return err
+			}
+		}
+		return
+	}
+
+	// if we are copying from one map to another with different types
+	if v.Kind() == reflect.Map && t.Kind() == reflect.Map && v.Type() != t.Type() {
+		if t.Type().Key().Kind() != reflect.String {
+			//lint:ignore ST1005 allow this error message to be capitalized
+			return fmt.Errorf("Unable to convert from %s to type %s", v.Type(), t.Type())
+		}
+
+		// replace whatever the map held before
+		m := reflect.MakeMapWithSize(t.Type(), v.Len())
+		for _, key := range v.MapKeys() {
+			item := reflect.New(t.Type().Elem()).Elem()
+			if err := copy(item, reflect.ValueOf(v.MapIndex(key).Interface())); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(fmt.Sprint(key.Interface())).Convert(t.Type().Key()), item)
+		}
+		t.Set(m)
+		return
+	}
+
 	// if we are copying from one slice or array to another
 	if isList(v) && isList(t) {
 		// loop over every item in the desired value
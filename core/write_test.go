@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -866,3 +867,347 @@ func check(t *testing.T, err error) {
 		t.Fatalf("Encountered error while writing answer: %v", err.Error())
 	}
 }
+
+func TestStructFields(t *testing.T) {
+	type Embedded struct {
+		Inner string
+	}
+	value := struct {
+		Embedded
+		Name    string `survey:"name"`
+		Skipped string `survey:"-"`
+		private string
+	}{}
+
+	fields, err := StructFields(&value)
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"Inner", "name"}, names)
+
+	_, err = StructFields(value)
+	assert.Error(t, err)
+}
+
+func TestRead_struct(t *testing.T) {
+	value := struct {
+		Name    string
+		Color   OptionAnswer `survey:"color"`
+		Days    []string
+		Timeout time.Duration
+	}{
+		Name:    "Johnny",
+		Color:   OptionAnswer{Value: "blue", Index: 1},
+		Days:    []string{"Monday"},
+		Timeout: time.Minute,
+	}
+
+	tests := map[string]interface{}{
+		"name":    "Johnny",
+		"color":   OptionAnswer{Value: "blue", Index: 1},
+		"Days":    []string{"Monday"},
+		"timeout": time.Minute,
+	}
+	for name, expected := range tests {
+		actual, err := ReadAnswer(&value, name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual, name)
+	}
+
+	_, err := ReadAnswer(&value, "missing")
+	assert.True(t, errors.Is(err, errFieldNotMatch{"missing"}))
+}
+
+func TestRead_map(t *testing.T) {
+	value := map[string]interface{}{"name": "Johnny"}
+
+	actual, err := ReadAnswer(&value, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "Johnny", actual)
+
+	_, err = ReadAnswer(&value, "missing")
+	assert.Error(t, err)
+}
+
+func TestRead_single(t *testing.T) {
+	name := "Johnny"
+	actual, err := ReadAnswer(&name, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Johnny", actual)
+
+	answer := OptionAnswer{Value: "blue", Index: 1}
+	actual, err = ReadAnswer(&answer, "")
+	assert.NoError(t, err)
+	assert.Equal(t, answer, actual)
+
+	_, err = ReadAnswer(name, "")
+	assert.Error(t, err)
+}
+
+func TestResetAnswer(t *testing.T) {
+	value := struct {
+		Name string
+		Days []string
+	}{Name: "Johnny", Days: []string{"Monday"}}
+
+	assert.NoError(t, ResetAnswer(&value, "name"))
+	assert.NoError(t, ResetAnswer(&value, "days"))
+	assert.Equal(t, "", value.Name)
+	assert.Nil(t, value.Days)
+
+	answers := map[string]interface{}{"name": "Johnny", "age": 30}
+	assert.NoError(t, ResetAnswer(&answers, "name"))
+	assert.Equal(t, map[string]interface{}{"age": 30}, answers)
+
+	name := "Johnny"
+	assert.NoError(t, ResetAnswer(&name, ""))
+	assert.Equal(t, "", name)
+
+	assert.Error(t, ResetAnswer(&value, "missing"))
+}
+
+func TestWrite_appendsToSlice(t *testing.T) {
+	value := struct {
+		Days []string
+	}{
+		Days: []string{"Monday"},
+	}
+
+	err := WriteAnswer(&value, "days", []OptionAnswer{{Value: "Tuesday", Index: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Monday", "Tuesday"}, value.Days)
+
+	// resetting the field first replaces the answer instead
+	assert.NoError(t, ResetAnswer(&value, "days"))
+	err = WriteAnswer(&value, "days", []OptionAnswer{{Value: "Tuesday", Index: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Tuesday"}, value.Days)
+}
+
+func TestWrite_canMapToStructSlice(t *testing.T) {
+	type port struct {
+		Host      int
+		Container string `survey:"target"`
+	}
+	value := struct {
+		Ports []port
+	}{}
+
+	err := WriteAnswer(&value, "ports", []map[string]interface{}{
+		{"host": "8080", "target": "80"},
+		{"host": "8443", "target": "443"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []port{{8080, "80"}, {8443, "443"}}, value.Ports)
+}
+
+func TestWrite_canConvertNumbers(t *testing.T) {
+	value := struct {
+		Port    uint16
+		Ratio   float32
+		Timeout time.Duration
+	}{}
+
+	assert.NoError(t, WriteAnswer(&value, "port", 8080))
+	assert.Equal(t, uint16(8080), value.Port)
+
+	assert.NoError(t, WriteAnswer(&value, "ratio", 0.1))
+	assert.Equal(t, float32(0.1), value.Ratio)
+
+	assert.NoError(t, WriteAnswer(&value, "timeout", int64(time.Second)))
+	assert.Equal(t, time.Second, value.Timeout)
+
+	// numbers that don't fit are not written
+	assert.Error(t, WriteAnswer(&value, "port", 70000))
+	assert.Error(t, WriteAnswer(&value, "port", -1))
+	assert.Error(t, WriteAnswer(&value, "port", 1.5))
+	assert.Equal(t, uint16(8080), value.Port)
+}
+
+func TestWrite_canWriteTimes(t *testing.T) {
+	when := time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC)
+
+	value := struct {
+		Start time.Time
+		End   *time.Time
+		Name  *string
+	}{}
+
+	assert.NoError(t, WriteAnswer(&value, "start", when))
+	assert.Equal(t, when, value.Start)
+
+	assert.NoError(t, WriteAnswer(&value, "end", when))
+	if assert.NotNil(t, value.End) {
+		assert.Equal(t, when, *value.End)
+	}
+
+	assert.NoError(t, WriteAnswer(&value, "name", "Johnny"))
+	if assert.NotNil(t, value.Name) {
+		assert.Equal(t, "Johnny", *value.Name)
+	}
+
+	// times can also be written on their own
+	single := time.Time{}
+	assert.NoError(t, WriteAnswer(&single, "", when))
+	assert.Equal(t, when, single)
+
+	pointer := (*time.Time)(nil)
+	assert.NoError(t, WriteAnswer(&pointer, "", when))
+	if assert.NotNil(t, pointer) {
+		assert.Equal(t, when, *pointer)
+	}
+
+	read, err := ReadAnswer(&single, "")
+	assert.NoError(t, err)
+	assert.Equal(t, when, read)
+}
+
+func TestWrite_canStringToTime(t *testing.T) {
+	value := time.Time{}
+
+	assert.NoError(t, WriteAnswer(&value, "", "2026-10-18T09:30:00Z"))
+	assert.Equal(t, time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC), value)
+
+	assert.Error(t, WriteAnswer(&value, "", "tomorrow"))
+}
+
+func TestWriteAnswer_treeAnswer(t *testing.T) {
+	answer := TreeAnswer{Value: "web", Path: []string{"default", "deployments", "web"}, Index: 2}
+
+	value := struct {
+		Node     TreeAnswer
+		Name     string
+		Path     []string
+		Any      interface{}
+		Names    []string
+		Resource *TreeAnswer
+	}{}
+
+	assert.NoError(t, WriteAnswer(&value, "node", answer))
+	assert.Equal(t, answer, value.Node)
+
+	assert.NoError(t, WriteAnswer(&value, "name", answer))
+	assert.Equal(t, "web", value.Name)
+
+	assert.NoError(t, WriteAnswer(&value, "path", answer))
+	assert.Equal(t, []string{"default", "deployments", "web"}, value.Path)
+
+	assert.NoError(t, WriteAnswer(&value, "any", answer))
+	assert.Equal(t, answer, value.Any)
+
+	assert.NoError(t, WriteAnswer(&value, "names", []TreeAnswer{answer, {Value: "db", Path: []string{"db"}}}))
+	assert.Equal(t, []string{"web", "db"}, value.Names)
+
+	assert.NoError(t, WriteAnswer(&value, "resource", answer))
+	if assert.NotNil(t, value.Resource) {
+		assert.Equal(t, answer, *value.Resource)
+	}
+
+	// tree answers can also be written on their own
+	single := TreeAnswer{}
+	assert.NoError(t, WriteAnswer(&single, "", answer))
+	assert.Equal(t, answer, single)
+
+	read, err := ReadAnswer(&single, "")
+	assert.NoError(t, err)
+	assert.Equal(t, answer, read)
+
+	number := 0
+	assert.Error(t, WriteAnswer(&number, "", answer))
+
+	assert.Equal(t, "default/deployments/web", answer.String())
+}
+
+func TestWrite_canWriteToTypedMaps(t *testing.T) {
+	ports := map[string]int{}
+	assert.NoError(t, WriteAnswer(&ports, "http", "8080"))
+	assert.NoError(t, WriteAnswer(&ports, "https", 8443))
+	assert.Equal(t, map[string]int{"http": 8080, "https": 8443}, ports)
+
+	// answers that can't be converted are not written
+	assert.Error(t, WriteAnswer(&ports, "ssh", "twenty-two"))
+	assert.Equal(t, map[string]int{"http": 8080, "https": 8443}, ports)
+
+	// a nil map is created for the answer
+	var labels map[string]string
+	assert.NoError(t, WriteAnswer(&labels, "tier", "web"))
+	assert.Equal(t, map[string]string{"tier": "web"}, labels)
+}
+
+func TestWrite_canWriteMapAnswers(t *testing.T) {
+	value := struct {
+		Env    map[string]string
+		Ports  map[string]int
+		Labels map[string]interface{}
+	}{
+		Env: map[string]string{"OLD": "1"},
+	}
+
+	assert.NoError(t, WriteAnswer(&value, "env", map[string]string{"PORT": "8080"}))
+	assert.Equal(t, map[string]string{"PORT": "8080"}, value.Env)
+
+	assert.NoError(t, WriteAnswer(&value, "ports", map[string]string{"http": "80"}))
+	assert.Equal(t, map[string]int{"http": 80}, value.Ports)
+
+	assert.NoError(t, WriteAnswer(&value, "labels", map[string]string{"tier": "web"}))
+	assert.Equal(t, map[string]interface{}{"tier": "web"}, value.Labels)
+
+	assert.Error(t, WriteAnswer(&value, "ports", map[string]string{"http": "eighty"}))
+
+	// without a name the answer fills the whole map
+	env := map[string]string{}
+	assert.NoError(t, WriteAnswer(&env, "", map[string]string{"PORT": "8080"}))
+	assert.Equal(t, map[string]string{"PORT": "8080"}, env)
+
+	actual, err := ReadAnswer(&env, "")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"PORT": "8080"}, actual)
+}
+
+func TestWriteAnswer_optionData(t *testing.T) {
+	type region string
+	type environment struct {
+		Name string
+		ID   int
+	}
+
+	prod := OptionAnswer{Value: "prod", Index: 1, Data: environment{Name: "Production", ID: 7}}
+
+	// the data is written in place of the value or the index
+	var env environment
+	assert.NoError(t, WriteAnswer(&env, "", prod))
+	assert.Equal(t, environment{Name: "Production", ID: 7}, env)
+
+	id := 0
+	assert.NoError(t, WriteAnswer(&id, "", OptionAnswer{Value: "prod", Index: 1, Data: 7}))
+	assert.Equal(t, 7, id)
+
+	var r region
+	assert.NoError(t, WriteAnswer(&r, "", OptionAnswer{Value: "eu", Index: 0, Data: region("eu-west-1")}))
+	assert.Equal(t, region("eu-west-1"), r)
+
+	// the answer itself is kept as it is
+	ans := OptionAnswer{}
+	assert.NoError(t, WriteAnswer(&ans, "", prod))
+	assert.Equal(t, prod, ans)
+
+	answers := struct {
+		Env   environment
+		IDs   []int64
+		Names map[string]int
+	}{}
+	assert.NoError(t, WriteAnswer(&answers, "env", prod))
+	assert.Equal(t, environment{Name: "Production", ID: 7}, answers.Env)
+
+	assert.NoError(t, WriteAnswer(&answers, "ids", []OptionAnswer{{Value: "dev", Data: 12}, {Value: "prod", Index: 1, Data: 7}}))
+	assert.Equal(t, []int64{12, 7}, answers.IDs)
+
+	assert.NoError(t, WriteAnswer(&answers.Names, "prod", OptionAnswer{Value: "prod", Index: 1, Data: 7}))
+	assert.Equal(t, map[string]int{"prod": 7}, answers.Names)
+
+	// data that doesn't fit the target isn't written
+	assert.Error(t, WriteAnswer(&id, "", prod))
+}
@@ -0,0 +1,513 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+DateTime is a prompt that shows a calendar to pick a date from. The arrow keys move the focused
+day, < and > change the month, and a date can also be typed using Layout. If PickTime is set, the
+time of day is picked after the date. Response type is a time.Time.
+
+	date := time.Time{}
+	prompt := &survey.DateTime{
+		Message: "When should the maintenance window start?",
+		Min:     time.Now(),
+	}
+	survey.AskOne(prompt, &date)
+*/
+type DateTime struct {
+	Renderer
+	Message string
+	Help    string
+	// Default is the date that has focus when the prompt starts, today if it is not set
+	Default time.Time
+	// Layout is used to type and show the answer, see time.Parse. It defaults to
+	// DateLayout or DateTimeLayout when PickTime is set.
+	Layout string
+	// Min and Max bound the answer when they are set
+	Min time.Time
+	Max time.Time
+	// PickTime asks for the time of day after the date
+	PickTime bool
+	// FirstWeekday is the day the weeks of the calendar start with
+	FirstWeekday time.Weekday
+
+	focus       time.Time
+	typed       string
+	pickingTime bool
+	editMinute  bool
+	showingHelp bool
+}
+
+// the default layouts used by DateTime
+const (
+	DateLayout     = "2006-01-02"
+	DateTimeLayout = "2006-01-02 15:04"
+)
+
+// DateTimeCell is a single day in the calendar of a DateTime
+type DateTimeCell struct {
+	Text     string
+	Focused  bool
+	Disabled bool
+}
+
+// data available to the templates when processing
+type DateTimeTemplateData struct {
+	DateTime
+	ShowAnswer  bool
+	ShowHelp    bool
+	Answer      string
+	Heading     string
+	Weekdays    []string
+	Weeks       [][]DateTimeCell
+	PickingTime bool
+	Hour        string
+	Minute      string
+	EditMinute  bool
+	Typed       string
+	TypedLayout string
+	Config      *PromptConfig
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var DateTimeQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else if .PickingTime}}
+  {{- color "cyan"}}[Use arrows to change the time, type {{ .TypedLayout }}
+  {{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}} {{ .Typed }}
+  {{- "\n"}}  {{ .Heading }}{{"\n"}}
+  {{- "  "}}{{if not .EditMinute}}{{color .Config.Icons.SelectFocus.Format}}[{{.Hour}}]{{color "reset"}}{{else}} {{.Hour}} {{end}}:
+  {{- if .EditMinute}}{{color .Config.Icons.SelectFocus.Format}}[{{.Minute}}]{{color "reset"}}{{else}} {{.Minute}} {{end}}{{"\n"}}
+{{- else}}
+  {{- color "cyan"}}[Use arrows to move, < and > to change month, type {{ .TypedLayout }}
+  {{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}} {{ .Typed }}
+  {{- "\n"}}  {{ .Heading }}{{"\n"}}
+  {{- range .Weekdays}} {{.}} {{end}}{{"\n"}}
+  {{- range .Weeks}}
+    {{- range .}}
+      {{- if .Focused}}{{color $.Config.Icons.SelectFocus.Format}}[{{.Text}}]{{color "reset"}}
+      {{- else if .Disabled}}{{color "black+h"}} {{.Text}} {{color "reset"}}
+      {{- else}} {{.Text}} {{end}}
+    {{- end}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// layout returns the layout used to type and show dates
+func (d *DateTime) layout() string {
+	if d.Layout != "" {
+		return d.Layout
+	}
+	if d.PickTime {
+		return DateTimeLayout
+	}
+	return DateLayout
+}
+
+// typedLayout returns the layout used to type what is currently being picked
+func (d *DateTime) typedLayout() string {
+	if d.pickingTime {
+		return "15:04"
+	}
+	return d.layout()
+}
+
+// location returns the time zone of the answer
+func (d *DateTime) location() *time.Location {
+	if !d.Default.IsZero() {
+		return d.Default.Location()
+	}
+	return time.Local
+}
+
+// day returns the start of the day of t
+func day(t time.Time) time.Time {
+	year, month, date := t.Date()
+	return time.Date(year, month, date, 0, 0, 0, 0, t.Location())
+}
+
+// clamp moves a time inside of the bounds of the prompt
+func (d *DateTime) clamp(t time.Time) time.Time {
+	if !d.Min.IsZero() && t.Before(d.lower()) {
+		t = d.lower()
+	}
+	if !d.Max.IsZero() && t.After(d.upper()) {
+		t = d.upper()
+	}
+	return t
+}
+
+// lower returns the earliest answer allowed
+func (d *DateTime) lower() time.Time {
+	if d.PickTime {
+		return d.Min.In(d.location())
+	}
+	return day(d.Min.In(d.location()))
+}
+
+// upper returns the latest answer allowed
+func (d *DateTime) upper() time.Time {
+	if d.PickTime {
+		return d.Max.In(d.location())
+	}
+	return day(d.Max.In(d.location()))
+}
+
+// inRange returns whether the day can be picked
+func (d *DateTime) inRange(t time.Time) bool {
+	if !d.Min.IsZero() && day(t).Before(day(d.lower())) {
+		return false
+	}
+	if !d.Max.IsZero() && day(t).After(day(d.upper())) {
+		return false
+	}
+	return true
+}
+
+// defaultValue returns the time that has focus when the prompt starts
+func (d *DateTime) defaultValue() time.Time {
+	value := d.Default
+	if value.IsZero() {
+		value = time.Now().In(d.location()).Truncate(time.Minute)
+	}
+	if !d.PickTime {
+		value = day(value)
+	}
+	return d.clamp(value)
+}
+
+// parse converts what the user typed into the answer
+func (d *DateTime) parse(text string) (time.Time, error) {
+	t, err := time.ParseInLocation(d.layout(), text, d.location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q does not match %s", text, d.layout())
+	}
+	return d.check(t, text)
+}
+
+// check cuts the time down to the day when the prompt doesn't pick times, and makes sure it is
+// inside of the bounds
+func (d *DateTime) check(t time.Time, text string) (time.Time, error) {
+	if !d.PickTime {
+		t = day(t)
+	}
+	if !d.clamp(t).Equal(t) {
+		return time.Time{}, d.rangeError(text)
+	}
+	return t, nil
+}
+
+// parseTime sets the time of the focused day to the time the user typed
+func (d *DateTime) parseTime(text string) (time.Time, error) {
+	clock, err := time.Parse("15:04", text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q does not match 15:04", text)
+	}
+	year, month, date := d.focus.Date()
+	t := time.Date(year, month, date, clock.Hour(), clock.Minute(), 0, 0, d.location())
+	if !d.clamp(t).Equal(t) {
+		return time.Time{}, d.rangeError(text)
+	}
+	return t, nil
+}
+
+func (d *DateTime) rangeError(text string) error {
+	switch {
+	case d.Min.IsZero():
+		return fmt.Errorf("%s is after %s", text, d.upper().Format(d.layout()))
+	case d.Max.IsZero():
+		return fmt.Errorf("%s is before %s", text, d.lower().Format(d.layout()))
+	}
+	return fmt.Errorf("%s is not between %s and %s", text, d.lower().Format(d.layout()), d.upper().Format(d.layout()))
+}
+
+// weeks returns the calendar of the month with the focused day
+func (d *DateTime) weeks() [][]DateTimeCell {
+	year, month, _ := d.focus.Date()
+	first := time.Date(year, month, 1, 0, 0, 0, 0, d.location())
+
+	// leave the days before the first of the month empty
+	week := []DateTimeCell{}
+	for i := 0; i < (int(first.Weekday())-int(d.FirstWeekday)+7)%7; i++ {
+		week = append(week, DateTimeCell{Text: "  ", Disabled: true})
+	}
+
+	weeks := [][]DateTimeCell{}
+	for date := first; date.Month() == month; date = date.AddDate(0, 0, 1) {
+		week = append(week, DateTimeCell{
+			Text:     fmt.Sprintf("%2d", date.Day()),
+			Focused:  date.Day() == d.focus.Day(),
+			Disabled: !d.inRange(date),
+		})
+		if len(week) == 7 {
+			weeks = append(weeks, week)
+			week = []DateTimeCell{}
+		}
+	}
+	if len(week) > 0 {
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+// weekdays returns the headers of the columns of the calendar
+func (d *DateTime) weekdays() []string {
+	days := []string{}
+	for i := 0; i < 7; i++ {
+		days = append(days, time.Weekday((int(d.FirstWeekday) + i) % 7).String()[:2])
+	}
+	return days
+}
+
+func (d *DateTime) templateData(config *PromptConfig) DateTimeTemplateData {
+	data := DateTimeTemplateData{
+		DateTime:    *d,
+		ShowHelp:    d.showingHelp,
+		Heading:     d.focus.Format("January 2006"),
+		Weekdays:    d.weekdays(),
+		Weeks:       d.weeks(),
+		PickingTime: d.pickingTime,
+		Hour:        d.focus.Format("15"),
+		Minute:      d.focus.Format("04"),
+		EditMinute:  d.editMinute,
+		Typed:       d.typed,
+		TypedLayout: d.typedLayout(),
+		Config:      config,
+	}
+
+	// show which day the time is picked for
+	if d.pickingTime {
+		data.Heading = d.focus.Format("Monday, January 2 2006")
+	}
+	return data
+}
+
+// OnChange is called on every keypress. It returns the answer once the user is done.
+func (d *DateTime) OnChange(key rune, config *PromptConfig) (bool, error) {
+	switch {
+	case key == terminal.KeyEnter || key == '\n':
+		return d.submit(config)
+	case key == terminal.KeyArrowLeft && d.pickingTime:
+		d.editMinute = false
+	case key == terminal.KeyArrowRight && d.pickingTime:
+		d.editMinute = true
+	case key == terminal.KeyArrowUp && d.pickingTime:
+		d.moveTime(1)
+	case key == terminal.KeyArrowDown && d.pickingTime:
+		d.moveTime(-1)
+	case key == terminal.KeyArrowLeft:
+		d.moveDate(0, -1)
+	case key == terminal.KeyArrowRight:
+		d.moveDate(0, 1)
+	case key == terminal.KeyArrowUp:
+		d.moveDate(0, -7)
+	case key == terminal.KeyArrowDown:
+		d.moveDate(0, 7)
+	case (key == '<' || key == '>') && !d.pickingTime:
+		months := 1
+		if key == '<' {
+			months = -1
+		}
+		d.moveDate(months, 0)
+	case key == terminal.KeyEscape || key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		d.typed = ""
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if d.typed != "" {
+			typed := []rune(d.typed)
+			d.typed = string(typed[:len(typed)-1])
+		}
+	case unicode.IsPrint(key):
+		d.typed += string(key)
+	}
+
+	return false, d.Render(DateTimeQuestionTemplate, d.templateData(config))
+}
+
+// submit handles the enter key. It returns true once the answer was picked.
+func (d *DateTime) submit(config *PromptConfig) (bool, error) {
+	typed := d.typed
+	d.typed = ""
+
+	switch {
+	case typed == config.HelpInput && d.Help != "":
+		d.showingHelp = true
+	case typed != "":
+		var t time.Time
+		var err error
+		if d.pickingTime {
+			t, err = d.parseTime(typed)
+		} else {
+			t, err = d.parse(typed)
+		}
+		if err != nil {
+			if err := d.Error(config, err); err != nil {
+				return false, err
+			}
+			break
+		}
+		// the layout includes the time of day when it is picked
+		d.focus = t
+		return true, nil
+	case d.PickTime && !d.pickingTime:
+		d.pickingTime = true
+	default:
+		return true, nil
+	}
+
+	return false, d.Render(DateTimeQuestionTemplate, d.templateData(config))
+}
+
+// moveDate moves the focus by the given number of months and days
+func (d *DateTime) moveDate(months, days int) {
+	year, month, date := d.focus.Date()
+	if months != 0 {
+		// stay on the last day of shorter months
+		last := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, d.location()).Day()
+		if date > last {
+			date = last
+		}
+	}
+	d.focus = d.clamp(time.Date(year, month+time.Month(months), date+days, d.focus.Hour(), d.focus.Minute(), 0, 0, d.location()))
+}
+
+// moveTime changes the hour or minute of the focused time
+func (d *DateTime) moveTime(step int) {
+	year, month, date := d.focus.Date()
+	hour, minute := d.focus.Hour(), d.focus.Minute()
+	if d.editMinute {
+		minute = (minute + step + 60) % 60
+	} else {
+		hour = (hour + step + 24) % 24
+	}
+	d.focus = d.clamp(time.Date(year, month, date, hour, minute, 0, 0, d.location()))
+}
+
+func (d *DateTime) Prompt(config *PromptConfig) (interface{}, error) {
+	if !d.Min.IsZero() && !d.Max.IsZero() && d.Max.Before(d.Min) {
+		return nil, errors.New("the maximum date is before the minimum date")
+	}
+	d.focus = d.defaultValue()
+	d.typed = ""
+	d.pickingTime = false
+	d.editMinute = false
+
+	if !d.interactive() {
+		return d.promptPlain(config)
+	}
+
+	cursor := d.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := d.Render(DateTimeQuestionTemplate, d.templateData(config)); err != nil {
+		return nil, err
+	}
+
+	rr := d.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			return nil, ErrGoBack
+		}
+		if r == terminal.KeyEndTransmission {
+			break
+		}
+		done, err := d.OnChange(r, config)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+	}
+
+	return d.focus, nil
+}
+
+// promptPlain reads the date typed in the layout of the prompt instead of showing the calendar.
+func (d *DateTime) promptPlain(config *PromptConfig) (interface{}, error) {
+	return d.askPlain(config, PlainTemplateData{
+		Message:      d.Message,
+		Help:         d.Help,
+		Instructions: d.layout(),
+		Default:      d.focus.Format(d.layout()),
+	}, func(line string) (interface{}, error) {
+		// if the line is empty
+		if line == "" {
+			// use the default value
+			return d.focus, nil
+		}
+		return d.parseAnswer(line)
+	})
+}
+
+// parseAnswer accepts a time from an AnswerSource, or a string in the layout of the prompt. Both
+// are checked against the bounds, and a time loses what the prompt can't pick, like its seconds.
+func (d *DateTime) parseAnswer(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return d.parse(v)
+	case time.Time:
+		return d.check(v.Truncate(time.Minute), v.Format(d.layout()))
+	}
+	return nil, fmt.Errorf("cannot use %T as the answer to a date", value)
+}
+
+// defaultAnswer returns the date the calendar starts on.
+func (d *DateTime) defaultAnswer() (interface{}, error) {
+	return d.defaultValue(), nil
+}
+
+// SetDefault uses the given time as the default answer.
+func (d *DateTime) SetDefault(value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		d.Default = v
+		return nil
+	case *time.Time:
+		if v != nil {
+			d.Default = *v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot use %T as the default of a date", value)
+}
+
+func (d *DateTime) Cleanup(config *PromptConfig, val interface{}) error {
+	if !d.interactive() {
+		return nil
+	}
+
+	answer := fmt.Sprint(val)
+	if t, ok := val.(time.Time); ok {
+		answer = t.Format(d.layout())
+	}
+
+	data := d.templateData(config)
+	data.ShowAnswer = true
+	data.Answer = answer
+	return d.Render(DateTimeQuestionTemplate, data)
+}
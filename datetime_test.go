@@ -0,0 +1,238 @@
+package survey
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDateTimeRender(t *testing.T) {
+	tests := []struct {
+		title    string
+		prompt   DateTime
+		data     DateTimeTemplateData
+		expected string
+	}{
+		{
+			"Test DateTime question output",
+			DateTime{Message: "When?", Default: date(2026, time.October, 8)},
+			DateTimeTemplateData{},
+			fmt.Sprintf("%s When? [Use arrows to move, < and > to change month, type 2006-01-02] \n", defaultIcons().Question.Text) +
+				"  October 2026\n" +
+				" Su  Mo  Tu  We  Th  Fr  Sa \n" +
+				"                  1   2   3 \n" +
+				"  4   5   6   7 [ 8]  9  10 \n" +
+				" 11  12  13  14  15  16  17 \n" +
+				" 18  19  20  21  22  23  24 \n" +
+				" 25  26  27  28  29  30  31 \n",
+		},
+		{
+			"Test DateTime question output starting on monday",
+			DateTime{Message: "When?", Default: date(2026, time.February, 28), FirstWeekday: time.Monday, Help: "This is helpful"},
+			DateTimeTemplateData{},
+			fmt.Sprintf("%s When? [Use arrows to move, < and > to change month, type 2006-01-02, %s for more help] \n", defaultIcons().Question.Text, defaultPromptConfig().HelpInput) +
+				"  February 2026\n" +
+				" Mo  Tu  We  Th  Fr  Sa  Su \n" +
+				"                          1 \n" +
+				"  2   3   4   5   6   7   8 \n" +
+				"  9  10  11  12  13  14  15 \n" +
+				" 16  17  18  19  20  21  22 \n" +
+				" 23  24  25  26  27 [28]\n",
+		},
+		{
+			"Test DateTime time output",
+			DateTime{Message: "When?", Default: time.Date(2026, time.October, 8, 9, 30, 0, 0, time.UTC), PickTime: true, pickingTime: true},
+			DateTimeTemplateData{},
+			fmt.Sprintf("%s When? [Use arrows to change the time, type 15:04] \n", defaultIcons().Question.Text) +
+				"  Thursday, October 8 2026\n" +
+				"  [09]: 30 \n",
+		},
+		{
+			"Test DateTime answer output",
+			DateTime{Message: "When?", Default: date(2026, time.October, 8)},
+			DateTimeTemplateData{ShowAnswer: true, Answer: "2026-10-08"},
+			fmt.Sprintf("%s When? 2026-10-08\n", defaultIcons().Question.Text),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.prompt.focus = test.prompt.defaultValue()
+
+			data := test.prompt.templateData(defaultPromptConfig())
+			data.ShowAnswer = test.data.ShowAnswer
+			data.Answer = test.data.Answer
+
+			err = test.prompt.Render(DateTimeQuestionTemplate, data)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestDateTimePrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"Test DateTime prompt interaction with default",
+			&DateTime{Message: "When?", Default: date(2026, time.October, 8)},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			date(2026, time.October, 8),
+		},
+		{
+			"Test DateTime prompt arrows move the focus",
+			&DateTime{Message: "When?", Default: date(2026, time.October, 8)},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				// a week later and a day back
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowLeft))
+				// the next month
+				c.Send(">")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			date(2026, time.November, 14),
+		},
+		{
+			"Test DateTime prompt stays on the last day of shorter months",
+			&DateTime{Message: "When?", Default: date(2026, time.January, 31)},
+			func(c expectConsole) {
+				c.ExpectString("January 2026")
+				c.Send(">")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			date(2026, time.February, 28),
+		},
+		{
+			"Test DateTime prompt stays in bounds",
+			&DateTime{Message: "When?", Default: date(2026, time.October, 8), Max: date(2026, time.October, 10)},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			date(2026, time.October, 10),
+		},
+		{
+			"Test DateTime prompt typed date",
+			&DateTime{Message: "When?", Default: date(2026, time.October, 8), Min: date(2026, time.October, 1)},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				c.SendLine("2026-09-30")
+				c.ExpectString("2026-09-30 is before 2026-10-01")
+				c.SendLine("2026-13-01")
+				c.ExpectString(`"2026-13-01" does not match 2006-01-02`)
+				c.SendLine("2026-12-24")
+				c.ExpectEOF()
+			},
+			date(2026, time.December, 24),
+		},
+		{
+			"Test DateTime prompt with time",
+			&DateTime{Message: "When?", Default: time.Date(2026, time.October, 8, 9, 30, 0, 0, time.UTC), PickTime: true},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				c.Send(string(terminal.KeyArrowRight))
+				c.SendLine("")
+				c.ExpectString("Friday, October 9 2026")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			time.Date(2026, time.October, 9, 10, 29, 0, 0, time.UTC),
+		},
+		{
+			"Test DateTime prompt typed time",
+			&DateTime{Message: "When?", Default: time.Date(2026, time.October, 8, 9, 30, 0, 0, time.UTC), PickTime: true},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				c.SendLine("")
+				c.ExpectString("Thursday, October 8 2026")
+				c.SendLine("17:45")
+				c.ExpectEOF()
+			},
+			time.Date(2026, time.October, 8, 17, 45, 0, 0, time.UTC),
+		},
+		{
+			"Test DateTime prompt interaction and prompt for help",
+			&DateTime{Message: "When?", Default: date(2026, time.October, 8), Help: "The first day of the window"},
+			func(c expectConsole) {
+				c.ExpectString("October 2026")
+				c.SendLine("?")
+				c.ExpectString("The first day of the window")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			date(2026, time.October, 8),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestDateTimeSetDefault(t *testing.T) {
+	prompt := &DateTime{}
+	when := date(2026, time.October, 8)
+
+	assert.NoError(t, prompt.SetDefault(when))
+	assert.Equal(t, when, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault(&when))
+	assert.Error(t, prompt.SetDefault((*time.Time)(nil)))
+	assert.Error(t, prompt.SetDefault("2026-10-08"))
+	assert.Equal(t, when, prompt.Default)
+}
+
+func TestDateTimeParseAnswer(t *testing.T) {
+	prompt := &DateTime{
+		Default: date(2026, time.October, 8),
+		Min:     date(2026, time.October, 1),
+		Max:     date(2026, time.October, 31),
+	}
+
+	// times from a source are checked like the typed ones
+	ans, err := prompt.parseAnswer(date(2026, time.October, 20).Add(15 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, date(2026, time.October, 20), ans)
+
+	_, err = prompt.parseAnswer(date(2026, time.November, 2))
+	assert.EqualError(t, err, "2026-11-02 is not between 2026-10-01 and 2026-10-31")
+
+	prompt.PickTime = true
+	ans, err = prompt.parseAnswer(date(2026, time.October, 20).Add(15*time.Hour + 30*time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, date(2026, time.October, 20).Add(15*time.Hour), ans)
+}
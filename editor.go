@@ -2,6 +2,7 @@ package survey
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -87,6 +88,11 @@ func (e *Editor) Prompt(config *PromptConfig) (interface{}, error) {
 }
 
 func (e *Editor) prompt(initialValue string, config *PromptConfig) (interface{}, error) {
+	// without a terminal we can't launch an editor so read a single line instead
+	if !e.interactive() {
+		return e.promptPlain(config)
+	}
+
 	// render the template
 	err := e.Render(
 		EditorQuestionTemplate,
@@ -121,6 +127,9 @@ func (e *Editor) prompt(initialValue string, config *PromptConfig) (interface{},
 		if r == terminal.KeyInterrupt {
 			return "", terminal.InterruptErr
 		}
+		if config.BackKey != 0 && r == config.BackKey {
+			return "", ErrGoBack
+		}
 		if r == terminal.KeyEndTransmission {
 			break
 		}
@@ -213,7 +222,48 @@ func (e *Editor) prompt(initialValue string, config *PromptConfig) (interface{},
 	return text, nil
 }
 
+// promptPlain takes the line the user types as the whole text, since there is no terminal to
+// open the editor in.
+func (e *Editor) promptPlain(config *PromptConfig) (interface{}, error) {
+	defaultValue := e.Default
+	if e.HideDefault {
+		defaultValue = ""
+	}
+
+	return e.askPlain(config, PlainTemplateData{
+		Message: e.Message,
+		Help:    e.Help,
+		Default: defaultValue,
+	}, func(line string) (interface{}, error) {
+		if line == "" {
+			return e.Default, nil
+		}
+		return line, nil
+	})
+}
+
+func (e *Editor) parseAnswer(value interface{}) (interface{}, error) {
+	return textAnswer(value), nil
+}
+
+// defaultAnswer returns the text the editor would start with.
+func (e *Editor) defaultAnswer() (interface{}, error) {
+	return e.Default, nil
+}
+
+func (e *Editor) SetDefault(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("cannot use %T as the default of an editor", value)
+	}
+	e.Default = str
+	return nil
+}
+
 func (e *Editor) Cleanup(config *PromptConfig, val interface{}) error {
+	if !e.interactive() {
+		return nil
+	}
 	return e.Render(
 		EditorQuestionTemplate,
 		EditorTemplateData{
@@ -2,6 +2,7 @@ package survey
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -64,6 +65,10 @@ var InputQuestionTemplate = `
 
 func (i *Input) onRune(config *PromptConfig) terminal.OnRuneFn {
 	return terminal.OnRuneFn(func(key rune, line []rune) ([]rune, bool, error) {
+		if config.BackKey != 0 && key == config.BackKey {
+			i.options = nil
+			return line, true, ErrGoBack
+		}
 		if i.options != nil && (key == terminal.KeyEnter || key == '\n') {
 			return []rune(i.answer), true, nil
 		} else if i.options != nil && key == terminal.KeyEscape {
@@ -83,6 +88,21 @@ func (i *Input) onRune(config *PromptConfig) terminal.OnRuneFn {
 				i.selectedIndex++
 			}
 			i.answer = i.options[i.selectedIndex].Value
+		} else if (key == terminal.SpecialKeyPageUp || key == terminal.SpecialKeyPageDown) && len(i.options) > 0 {
+			delta := config.PageSize
+			if key == terminal.SpecialKeyPageUp {
+				delta = -delta
+			}
+			// pages stop at the ends of the suggestions instead of going around
+			i.selectedIndex = moveBy(nil, i.options, i.selectedIndex, delta)
+			i.answer = i.options[i.selectedIndex].Value
+		} else if (key == terminal.SpecialKeyHome || key == terminal.SpecialKeyEnd) && len(i.options) > 0 {
+			// go to the first or the last suggestion
+			i.selectedIndex = 0
+			if key == terminal.SpecialKeyEnd {
+				i.selectedIndex = len(i.options) - 1
+			}
+			i.answer = i.options[i.selectedIndex].Value
 		} else if key == terminal.KeyTab && i.Suggest != nil {
 			i.answer = string(line)
 			i.typedAnswer = i.answer
@@ -104,7 +124,7 @@ func (i *Input) onRune(config *PromptConfig) terminal.OnRuneFn {
 				return line, false, nil
 			}
 
-			if key >= terminal.KeySpace {
+			if terminal.IsTyped(key) {
 				i.answer += string(key)
 			}
 			i.typedAnswer = i.answer
@@ -137,6 +157,10 @@ func (i *Input) onRune(config *PromptConfig) terminal.OnRuneFn {
 var errReadLineAgain = errors.New("read line again")
 
 func (i *Input) Prompt(config *PromptConfig) (interface{}, error) {
+	if !i.interactive() {
+		return i.promptPlain(config)
+	}
+
 	// render the template
 	err := i.Render(
 		InputQuestionTemplate,
@@ -206,7 +230,45 @@ func (i *Input) Prompt(config *PromptConfig) (interface{}, error) {
 	return lineStr, err
 }
 
+// promptPlain reads the answer without offering any suggestions, which need a terminal to be
+// shown.
+func (i *Input) promptPlain(config *PromptConfig) (interface{}, error) {
+	return i.askPlain(config, PlainTemplateData{
+		Message: i.Message,
+		Help:    i.Help,
+		Default: i.Default,
+	}, func(line string) (interface{}, error) {
+		// if the line is empty
+		if line == "" {
+			// use the default value
+			return i.Default, nil
+		}
+		return line, nil
+	})
+}
+
+func (i *Input) parseAnswer(value interface{}) (interface{}, error) {
+	return textAnswer(value), nil
+}
+
+// defaultAnswer returns the answer given by pressing enter straight away.
+func (i *Input) defaultAnswer() (interface{}, error) {
+	return i.Default, nil
+}
+
+func (i *Input) SetDefault(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("cannot use %T as the default of an input", value)
+	}
+	i.Default = str
+	return nil
+}
+
 func (i *Input) Cleanup(config *PromptConfig, val interface{}) error {
+	if !i.interactive() {
+		return nil
+	}
 	return i.Render(
 		InputQuestionTemplate,
 		InputTemplateData{
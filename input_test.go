@@ -431,3 +431,52 @@ func TestInputPrompt(t *testing.T) {
 		})
 	}
 }
+
+func TestInputSuggestionNavigation(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"end and page up",
+			&Input{
+				Message: "Choose an option:",
+				Suggest: func(string) []string { return numberedOptions(20) },
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an option:")
+				c.Send(string(terminal.KeyTab))
+				c.ExpectString("option-1")
+				c.Send(endSequence)
+				c.ExpectString("option-19")
+				c.Send(pageUpSequence)
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			"option-12",
+		},
+		{
+			"page down and home",
+			&Input{
+				Message: "Choose an option:",
+				Suggest: func(string) []string { return numberedOptions(20) },
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an option:")
+				c.Send(string(terminal.KeyTab))
+				c.ExpectString("option-1")
+				c.Send(pageDownSequence)
+				c.ExpectString("option-9")
+				c.Send(homeSequence)
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			"option-1",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
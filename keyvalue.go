@@ -0,0 +1,515 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+KeyValue is a prompt for editing a list of key/value pairs, such as environment variables or
+labels. The arrow keys move between the rows, tab switches between the key and the value and
+enter on an empty row finishes the prompt. Every key is checked with ValidateKey and keys can't be
+used twice. Response type is a map[string]string. Default holds the pairs the list starts with.
+
+	env := map[string]string{}
+	prompt := &survey.KeyValue{
+		Message: "Environment variables:",
+		Default: map[string]string{"PORT": "8080"},
+	}
+	survey.AskOne(prompt, &env)
+*/
+type KeyValue struct {
+	Renderer
+	Message       string
+	Default       map[string]string
+	Help          string
+	PageSize      int
+	ValidateKey   Validator
+	rows          []KeyValueRow
+	selectedIndex int
+	column        int
+	showingHelp   bool
+}
+
+// KeyValueRow is a pair of a KeyValue prompt
+type KeyValueRow struct {
+	Key   string
+	Value string
+}
+
+// the columns of a key value row
+const (
+	keyColumn = iota
+	valueColumn
+)
+
+// KeyValueTemplateData is the data available to the templates when processing
+type KeyValueTemplateData struct {
+	KeyValue
+	PageEntries   []KeyValueRow
+	SelectedIndex int
+	// Column is 0 when the key of the selected row is being edited and 1 for its value
+	Column int
+	// KeyWidth is how wide the keys are printed so that the values line up
+	KeyWidth   int
+	Answer     string
+	ShowAnswer bool
+	ShowHelp   bool
+	Config     *PromptConfig
+}
+
+var KeyValueQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, tab to switch between key and value, enter on an empty row to finish{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- if eq $ix $.SelectedIndex }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }} {{color "reset"}}{{else}}  {{end}}
+    {{- if and (eq $ix $.SelectedIndex) (eq $.Column 0) }}{{color "cyan+b"}}{{ printf "%-*s" $.KeyWidth (printf "%s_" $row.Key) }}{{color "reset"}}
+    {{- else}}{{ printf "%-*s" $.KeyWidth $row.Key }}{{end}}
+    {{- " = "}}
+    {{- if and (eq $ix $.SelectedIndex) (eq $.Column 1) }}{{color "cyan+b"}}{{ $row.Value }}_{{color "reset"}}
+    {{- else}}{{ $row.Value }}{{end}}
+    {{- "\n"}}
+  {{- end}}
+{{- end}}`
+
+// keyValueRows returns the pairs of the map as rows sorted by their keys
+func keyValueRows(pairs map[string]string) []KeyValueRow {
+	rows := []KeyValueRow{}
+	for key, value := range pairs {
+		rows = append(rows, KeyValueRow{Key: key, Value: value})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Key < rows[j].Key
+	})
+	return rows
+}
+
+// formatKeyValues shows the pairs of the map sorted by their keys
+func formatKeyValues(pairs map[string]string) string {
+	formatted := []string{}
+	for _, row := range keyValueRows(pairs) {
+		formatted = append(formatted, row.Key+"="+row.Value)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// parseKeyValue reads a pair written as key=value
+func parseKeyValue(text string) (KeyValueRow, error) {
+	parts := strings.SplitN(text, "=", 2)
+	if len(parts) != 2 {
+		return KeyValueRow{}, fmt.Errorf("%q is not a key=value pair", strings.TrimSpace(text))
+	}
+	return KeyValueRow{Key: strings.TrimSpace(parts[0]), Value: strings.TrimSpace(parts[1])}, nil
+}
+
+// checkRow returns an error if the row with the given index can't be part of the answer
+func (k *KeyValue) checkRow(rows []KeyValueRow, index int) error {
+	row := rows[index]
+	if row.Key == "" {
+		return errors.New("the key can't be empty")
+	}
+	for i, other := range rows {
+		if i != index && other.Key == row.Key {
+			return fmt.Errorf("%q is used more than once", row.Key)
+		}
+	}
+	if k.ValidateKey != nil {
+		return k.ValidateKey(row.Key)
+	}
+	return nil
+}
+
+// answer returns the map for the given rows, leaving out the empty ones. If a row is invalid it
+// returns its index along with the error.
+func (k *KeyValue) answer(rows []KeyValueRow) (map[string]string, int, error) {
+	pairs := map[string]string{}
+	for i, row := range rows {
+		if row.Key == "" && row.Value == "" {
+			continue
+		}
+		if err := k.checkRow(rows, i); err != nil {
+			return nil, i, err
+		}
+		pairs[row.Key] = row.Value
+	}
+	return pairs, 0, nil
+}
+
+// edit changes the text of the selected cell
+func (k *KeyValue) edit(change func(text string) string) {
+	row := &k.rows[k.selectedIndex]
+	if k.column == keyColumn {
+		row.Key = change(row.Key)
+	} else {
+		row.Value = change(row.Value)
+	}
+}
+
+// cell returns the text of the selected cell
+func (k *KeyValue) cell() string {
+	row := k.rows[k.selectedIndex]
+	if k.column == keyColumn {
+		return row.Key
+	}
+	return row.Value
+}
+
+// next moves to the value of the selected row, or to the key of the next row once the row is
+// done. An error is returned if the row can't be left the way it is.
+func (k *KeyValue) next() error {
+	if err := k.checkRow(k.rows, k.selectedIndex); err != nil {
+		return err
+	}
+
+	if k.column == keyColumn {
+		k.column = valueColumn
+		return nil
+	}
+
+	// start a new row after the last one
+	if k.selectedIndex == len(k.rows)-1 {
+		k.rows = append(k.rows, KeyValueRow{})
+	}
+	k.selectedIndex++
+	k.column = keyColumn
+	return nil
+}
+
+// remove deletes the selected row, keeping an empty one if it was the only row
+func (k *KeyValue) remove() {
+	if len(k.rows) == 1 {
+		k.rows[0] = KeyValueRow{}
+		k.column = keyColumn
+		return
+	}
+
+	k.rows = append(k.rows[:k.selectedIndex], k.rows[k.selectedIndex+1:]...)
+	if k.selectedIndex == len(k.rows) {
+		k.selectedIndex--
+	}
+}
+
+// OnChange is called on every keypress. It returns true once the user is done.
+func (k *KeyValue) OnChange(key rune, config *PromptConfig) bool {
+	row := k.rows[k.selectedIndex]
+	empty := row.Key == "" && row.Value == ""
+
+	var err error
+	if key == terminal.KeyEnter || key == '\n' {
+		if empty {
+			return k.finish(config)
+		}
+		err = k.next()
+	} else if key == '=' && k.column == keyColumn {
+		// typing key=value moves on to the value
+		err = k.next()
+	} else if key == terminal.KeyArrowUp {
+		k.selectedIndex = (k.selectedIndex - 1 + len(k.rows)) % len(k.rows)
+	} else if key == terminal.KeyArrowDown {
+		k.selectedIndex = (k.selectedIndex + 1) % len(k.rows)
+	} else if key == terminal.KeyTab {
+		k.column = 1 - k.column
+	} else if key == terminal.KeyArrowLeft {
+		k.column = keyColumn
+	} else if key == terminal.KeyArrowRight {
+		k.column = valueColumn
+	} else if key == terminal.SpecialKeyDelete {
+		k.remove()
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		k.edit(func(string) string { return "" })
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if text := []rune(k.cell()); len(text) > 0 {
+			k.edit(func(string) string { return string(text[:len(text)-1]) })
+		} else if k.column == valueColumn {
+			k.column = keyColumn
+		} else if empty && len(k.rows) > 1 {
+			// go back to the end of the previous row
+			index := k.selectedIndex
+			k.remove()
+			if index > 0 {
+				k.selectedIndex = index - 1
+			}
+			k.column = valueColumn
+		}
+	} else if string(key) == config.HelpInput && k.Help != "" && k.cell() == "" && !k.showingHelp {
+		k.showingHelp = true
+	} else if terminal.IsTyped(key) {
+		k.edit(func(text string) string { return text + string(key) })
+	}
+
+	if err != nil {
+		return k.fail(config, err)
+	}
+
+	_ = k.render(config)
+	return false
+}
+
+// finish returns true if every row can be part of the answer, otherwise it shows the first one
+// that can't and keeps prompting
+func (k *KeyValue) finish(config *PromptConfig) bool {
+	if _, index, err := k.answer(k.rows); err != nil {
+		k.selectedIndex = index
+		k.column = keyColumn
+		return k.fail(config, err)
+	}
+	return true
+}
+
+// fail shows why the selected row can't be used and keeps prompting
+func (k *KeyValue) fail(config *PromptConfig, err error) bool {
+	_ = k.Error(config, err)
+	_ = k.render(config)
+	return false
+}
+
+// render shows the page of the rows with the selected one
+func (k *KeyValue) render(config *PromptConfig) error {
+	pageSize := k.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	choices := []core.OptionAnswer{}
+	width := 0
+	for i, row := range k.rows {
+		choices = append(choices, core.OptionAnswer{Value: row.Key, Index: i})
+		if n := len([]rune(row.Key)); n > width {
+			width = n
+		}
+	}
+	opts, idx := paginate(pageSize, choices, k.selectedIndex)
+
+	entries := []KeyValueRow{}
+	for _, opt := range opts {
+		entries = append(entries, k.rows[opt.Index])
+	}
+
+	return k.Render(KeyValueQuestionTemplate, KeyValueTemplateData{
+		KeyValue:      *k,
+		PageEntries:   entries,
+		SelectedIndex: idx,
+		Column:        k.column,
+		// leave room for the cursor after the key
+		KeyWidth: width + 1,
+		ShowHelp: k.showingHelp,
+		Config:   config,
+	})
+}
+
+// start lists the default pairs followed by an empty row to add more
+func (k *KeyValue) start() error {
+	rows := keyValueRows(k.Default)
+	if _, _, err := k.answer(rows); err != nil {
+		return fmt.Errorf("default value %w", err)
+	}
+
+	k.rows = append(rows, KeyValueRow{})
+	k.selectedIndex = len(k.rows) - 1
+	k.column = keyColumn
+	return nil
+}
+
+func (k *KeyValue) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := k.start(); err != nil {
+		return nil, err
+	}
+
+	if !k.interactive() {
+		return k.promptPlain(config)
+	}
+
+	cursor := k.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := k.render(config); err != nil {
+		return nil, err
+	}
+
+	rr := k.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			return nil, ErrGoBack
+		}
+		if r == terminal.KeyEndTransmission {
+			if k.finish(config) {
+				break
+			}
+			continue
+		}
+		if k.OnChange(r, config) {
+			break
+		}
+	}
+
+	pairs, _, err := k.answer(k.rows)
+	return pairs, err
+}
+
+// promptPlain reads a key=value pair from every line until an empty line or the end of the input,
+// since a single line can't hold the whole list.
+func (k *KeyValue) promptPlain(config *PromptConfig) (interface{}, error) {
+	showHelp := false
+	for {
+		err := k.renderPlain(PlainQuestionTemplate, PlainTemplateData{
+			Message:      k.Message,
+			Help:         k.Help,
+			ShowHelp:     showHelp,
+			Instructions: "[Enter key=value pairs on separate lines and an empty line to finish]",
+			Default:      formatKeyValues(k.Default),
+			Config:       config,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rows := []KeyValueRow{}
+		askedForHelp := false
+		for {
+			line, err := k.readLine()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if line == config.HelpInput && k.Help != "" && len(rows) == 0 {
+				askedForHelp = true
+				break
+			}
+			if strings.TrimSpace(line) == "" {
+				break
+			}
+
+			row, err := parseKeyValue(line)
+			if err == nil {
+				err = k.checkRow(append(rows, row), len(rows))
+			}
+			if err != nil {
+				// skip the pair and keep reading the others
+				if err := k.Error(config, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			rows = append(rows, row)
+		}
+
+		if askedForHelp {
+			// ask again with the help
+			showHelp = true
+			continue
+		}
+
+		if len(rows) == 0 {
+			pairs, _, err := k.answer(keyValueRows(k.Default))
+			return pairs, err
+		}
+		pairs, _, err := k.answer(rows)
+		return pairs, err
+	}
+}
+
+// parseAnswer accepts a map from an AnswerSource, or a string with key=value pairs separated by
+// commas.
+func (k *KeyValue) parseAnswer(value interface{}) (interface{}, error) {
+	rows := []KeyValueRow{}
+	switch v := value.(type) {
+	case map[string]string:
+		rows = keyValueRows(v)
+	case map[string]interface{}:
+		pairs := map[string]string{}
+		for key, val := range v {
+			pairs[key] = fmt.Sprint(val)
+		}
+		rows = keyValueRows(pairs)
+	case string:
+		for _, part := range strings.Split(v, ",") {
+			if strings.TrimSpace(part) == "" {
+				continue
+			}
+			row, err := parseKeyValue(part)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+	default:
+		return nil, fmt.Errorf("cannot use %T as the answer to a key value", value)
+	}
+
+	pairs, _, err := k.answer(rows)
+	return pairs, err
+}
+
+// defaultAnswer returns the default pairs if they pass the checks of the prompt.
+func (k *KeyValue) defaultAnswer() (interface{}, error) {
+	pairs, _, err := k.answer(keyValueRows(k.Default))
+	return pairs, err
+}
+
+// SetDefault uses the given pairs as the ones the list starts with. It accepts a map with string
+// keys and any values.
+func (k *KeyValue) SetDefault(value interface{}) error {
+	pairs := map[string]string{}
+	switch v := value.(type) {
+	case map[string]string:
+		for key, val := range v {
+			pairs[key] = val
+		}
+	case map[string]interface{}:
+		for key, val := range v {
+			pairs[key] = fmt.Sprint(val)
+		}
+	default:
+		return fmt.Errorf("cannot use %T as the default of a key value", value)
+	}
+
+	if _, _, err := k.answer(keyValueRows(pairs)); err != nil {
+		return err
+	}
+	k.Default = pairs
+	return nil
+}
+
+func (k *KeyValue) Cleanup(config *PromptConfig, val interface{}) error {
+	if !k.interactive() {
+		return nil
+	}
+	return k.Render(
+		KeyValueQuestionTemplate,
+		KeyValueTemplateData{
+			KeyValue:   *k,
+			Answer:     formatKeyValues(val.(map[string]string)),
+			ShowAnswer: true,
+			Config:     config,
+		},
+	)
+}
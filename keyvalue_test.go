@@ -0,0 +1,236 @@
+package survey
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestKeyValueRender(t *testing.T) {
+	prompt := KeyValue{
+		Message: "Environment variables:",
+	}
+
+	helpfulPrompt := prompt
+	helpfulPrompt.Help = "This is helpful"
+
+	rows := []KeyValueRow{{Key: "DEBUG", Value: "true"}, {Key: "PORT", Value: "8080"}, {}}
+
+	tests := []struct {
+		title    string
+		prompt   KeyValue
+		data     KeyValueTemplateData
+		expected string
+	}{
+		{
+			"Test KeyValue question output editing a key",
+			prompt,
+			KeyValueTemplateData{PageEntries: rows, SelectedIndex: 1, KeyWidth: 6},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Environment variables:  [Use arrows to move, tab to switch between key and value, enter on an empty row to finish]", defaultIcons().Question.Text),
+					"  DEBUG  = true",
+					fmt.Sprintf("%s PORT_  = 8080", defaultIcons().SelectFocus.Text),
+					"         = \n",
+				},
+				"\n",
+			),
+		},
+		{
+			"Test KeyValue question output editing a value",
+			helpfulPrompt,
+			KeyValueTemplateData{PageEntries: rows[:1], Column: 1, KeyWidth: 6},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Environment variables:  [Use arrows to move, tab to switch between key and value, enter on an empty row to finish, %s for more help]", defaultIcons().Question.Text, defaultPromptConfig().HelpInput),
+					fmt.Sprintf("%s DEBUG  = true_\n", defaultIcons().SelectFocus.Text),
+				},
+				"\n",
+			),
+		},
+		{
+			"Test KeyValue answer output",
+			prompt,
+			KeyValueTemplateData{Answer: "DEBUG=true, PORT=8080", ShowAnswer: true},
+			fmt.Sprintf("%s Environment variables: DEBUG=true, PORT=8080\n", defaultIcons().Question.Text),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.data.KeyValue = test.prompt
+
+			// set the icon set
+			test.data.Config = defaultPromptConfig()
+
+			err = test.prompt.Render(
+				KeyValueQuestionTemplate,
+				test.data,
+			)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestKeyValuePrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"add pairs",
+			&KeyValue{
+				Message: "Environment variables:",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Environment variables:")
+				c.SendLine("PORT")
+				c.SendLine("8080")
+				// typing = moves on to the value
+				c.Send("DEBUG=true")
+				c.SendLine("")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			map[string]string{"PORT": "8080", "DEBUG": "true"},
+		},
+		{
+			"default pairs",
+			&KeyValue{
+				Message: "Environment variables:",
+				Default: map[string]string{"PORT": "8080"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Environment variables:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			map[string]string{"PORT": "8080"},
+		},
+		{
+			"edit and delete pairs",
+			&KeyValue{
+				Message: "Environment variables:",
+				Default: map[string]string{"DEBUG": "true", "PORT": "8080"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Environment variables:")
+				// change the value of PORT
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyTab))
+				c.Send(strings.Repeat(string(terminal.KeyBackspace), 4))
+				c.Send("9090")
+				// delete DEBUG
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.SpecialKeyDelete))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			map[string]string{"PORT": "9090"},
+		},
+		{
+			"invalid keys are rejected",
+			&KeyValue{
+				Message: "Environment variables:",
+				Default: map[string]string{"PORT": "8080"},
+				ValidateKey: func(val interface{}) error {
+					if strings.ToUpper(val.(string)) != val.(string) {
+						return errors.New("keys must be upper case")
+					}
+					return nil
+				},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Environment variables:")
+				c.SendLine("PORT")
+				c.ExpectString(`"PORT" is used more than once`)
+				c.Send(strings.Repeat(string(terminal.KeyBackspace), 4))
+				c.SendLine("debug")
+				c.ExpectString("keys must be upper case")
+				c.Send(string(terminal.KeyDeleteLine))
+				c.Send("DEBUG=1")
+				c.SendLine("")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			map[string]string{"PORT": "8080", "DEBUG": "1"},
+		},
+		{
+			"prompt for help",
+			&KeyValue{
+				Message: "Environment variables:",
+				Help:    "The environment of the service",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Environment variables:")
+				c.Send("?")
+				c.ExpectString("The environment of the service")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestKeyValueSetDefault(t *testing.T) {
+	prompt := &KeyValue{}
+
+	assert.NoError(t, prompt.SetDefault(map[string]string{"PORT": "8080"}))
+	assert.Equal(t, map[string]string{"PORT": "8080"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault(map[string]interface{}{"PORT": 9090}))
+	assert.Equal(t, map[string]string{"PORT": "9090"}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault(map[string]string{"": "8080"}))
+	assert.Error(t, prompt.SetDefault("PORT=8080"))
+	assert.Equal(t, map[string]string{"PORT": "9090"}, prompt.Default)
+}
+
+func TestKeyValue_WriteAnswer(t *testing.T) {
+	answers := struct {
+		Env   map[string]string
+		Ports map[string]int
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "env", Prompt: &KeyValue{Message: "Environment variables:"}},
+		{Name: "ports", Prompt: &KeyValue{Message: "Ports:"}},
+	}, &answers, WithAnswerSource(MapSource{
+		"env":   "PORT=8080, DEBUG=true",
+		"ports": map[string]interface{}{"http": 80, "https": "443"},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"PORT": "8080", "DEBUG": "true"}, answers.Env)
+	assert.Equal(t, map[string]int{"http": 80, "https": 443}, answers.Ports)
+
+	env := map[string]string{}
+	err = AskOne(&KeyValue{Message: "Environment variables:"}, &env, WithAnswerSource(MapSource{"": "PORT=8080"}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"PORT": "8080"}, env)
+}
@@ -0,0 +1,113 @@
+package survey
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// optionsLoadDelay is how long the user has to stop typing before the options are loaded again
+const optionsLoadDelay = 200 * time.Millisecond
+
+// optionLoader calls the LoadOptions of a Select or a MultiSelect in the background. The results
+// are handed to the prompt with the lock held, so the prompt holds it too while it handles a key
+// and the two never change or render the prompt at the same time.
+type optionLoader struct {
+	mutex sync.Mutex
+	load  func(ctx context.Context, filter string) ([]string, error)
+	apply func(options []string, err error)
+	// filter is the one the last load was asked for
+	filter  string
+	loads   int
+	loading bool
+	err     error
+	stopped bool
+	timer   *time.Timer
+	cancel  context.CancelFunc
+}
+
+func newOptionLoader(load func(ctx context.Context, filter string) ([]string, error), apply func(options []string, err error)) *optionLoader {
+	return &optionLoader{load: load, apply: apply}
+}
+
+// lock and unlock do nothing for the prompts that don't load their options
+func (l *optionLoader) lock() {
+	if l != nil {
+		l.mutex.Lock()
+	}
+}
+
+func (l *optionLoader) unlock() {
+	if l != nil {
+		l.mutex.Unlock()
+	}
+}
+
+// request loads the options for the filter once the delay is over, dropping the results of the
+// loads that were requested before. The lock must be held.
+func (l *optionLoader) request(filter string, delay time.Duration) {
+	if l.stopped {
+		return
+	}
+	l.abort()
+
+	l.loads++
+	loads := l.loads
+	l.filter = filter
+	l.loading = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.timer = time.AfterFunc(delay, func() {
+		options, err := l.load(ctx, filter)
+
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+
+		// the user typed something else in the meantime or is done with the prompt
+		if l.stopped || loads != l.loads {
+			return
+		}
+		l.loading = false
+		l.err = err
+		l.apply(options, err)
+	})
+}
+
+// refresh loads the options again when the filter changed. The lock must be held.
+func (l *optionLoader) refresh(filter string) {
+	if l != nil && filter != l.filter {
+		l.request(filter, optionsLoadDelay)
+	}
+}
+
+// abort cancels the load in progress and the one waiting for the delay
+func (l *optionLoader) abort() {
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	if l.cancel != nil {
+		l.cancel()
+	}
+}
+
+// stop drops the results of any load in progress once the prompt is done
+func (l *optionLoader) stop() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.stopped = true
+	l.abort()
+}
+
+// status returns whether options are being loaded and why the last load failed. The lock must
+// be held.
+func (l *optionLoader) status() (bool, error) {
+	if l == nil {
+		return false, nil
+	}
+	return l.loading, l.err
+}
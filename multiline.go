@@ -1,6 +1,8 @@
 package survey
 
 import (
+	"fmt"
+	"io"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -36,6 +38,10 @@ var MultilineQuestionTemplate = `
 {{- end}}`
 
 func (i *Multiline) Prompt(config *PromptConfig) (interface{}, error) {
+	if !i.interactive() {
+		return i.promptPlain(config)
+	}
+
 	// render the template
 	err := i.Render(
 		MultilineQuestionTemplate,
@@ -63,7 +69,7 @@ func (i *Multiline) Prompt(config *PromptConfig) (interface{}, error) {
 	// get the next line
 	for {
 		var line []rune
-		line, err = rr.ReadLine(0)
+		line, err = rr.ReadLine(0, goBackOnRune(config))
 		if err != nil {
 			return string(line), err
 		}
@@ -99,7 +105,71 @@ func (i *Multiline) Prompt(config *PromptConfig) (interface{}, error) {
 	return val, err
 }
 
+// promptPlain reads lines until two empty lines in a row or the end of the input, since a
+// single line can't hold the whole answer.
+func (i *Multiline) promptPlain(config *PromptConfig) (interface{}, error) {
+	err := i.renderPlain(PlainQuestionTemplate, PlainTemplateData{
+		Message:      i.Message,
+		Instructions: "[Enter 2 empty lines to finish]",
+		Default:      i.Default,
+		Config:       config,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	multiline := make([]string, 0)
+	emptyOnce := false
+	for {
+		line, err := i.readLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if line == "" {
+			if emptyOnce {
+				break
+			}
+			emptyOnce = true
+		} else {
+			emptyOnce = false
+		}
+		multiline = append(multiline, line)
+	}
+
+	val := strings.TrimSpace(strings.Join(multiline, "\n"))
+	if len(val) == 0 {
+		// use the default value
+		return i.Default, nil
+	}
+	return val, nil
+}
+
+func (i *Multiline) parseAnswer(value interface{}) (interface{}, error) {
+	return textAnswer(value), nil
+}
+
+// defaultAnswer returns the answer given by finishing without typing anything.
+func (i *Multiline) defaultAnswer() (interface{}, error) {
+	return i.Default, nil
+}
+
+func (i *Multiline) SetDefault(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("cannot use %T as the default of a multiline", value)
+	}
+	i.Default = str
+	return nil
+}
+
 func (i *Multiline) Cleanup(config *PromptConfig, val interface{}) error {
+	if !i.interactive() {
+		return nil
+	}
 	return i.Render(
 		MultilineQuestionTemplate,
 		MultilineTemplateData{
@@ -1,8 +1,10 @@
 package survey
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -18,11 +20,25 @@ for them to select using the arrow keys and enter. Response type is a slice of s
 		Options: []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
 	}
 	survey.AskOne(prompt, &days)
+
+LoadOptions works like the one of a Select. The options the user checked stay in the list when
+they aren't part of the results.
+
+Other adds an entry after the options that lets the user type an answer of their own when they
+select it, which ValidateOther can check. It is added to the answers as a core.OptionAnswer with
+core.OtherIndex as its Index.
+
+Choices work like the ones of a Select. The disabled options can't be checked or unchecked, so the
+defaults can check options the user can't take away.
+
+When the prompt is asked with WithHotkeys, pressing the key of an option checks or unchecks it
+like a Select, as long as the user hasn't started to filter.
 */
 type MultiSelect struct {
 	Renderer
 	Message       string
 	Options       []string
+	Choices       []Option
 	Default       interface{}
 	Help          string
 	PageSize      int
@@ -30,10 +46,25 @@ type MultiSelect struct {
 	FilterMessage string
 	Filter        func(filter string, value string, index int) bool
 	Description   func(value string, index int) string
+	LoadOptions   func(ctx context.Context, filter string) ([]string, error)
+	Other         string
+	ValidateOther Validator
+	// the options being shown, which are the ones that were loaded once LoadOptions returns
+	options       []string
+	choices       []Option
 	filter        string
 	selectedIndex int
 	checked       map[int]bool
 	showingHelp   bool
+	loader        *optionLoader
+	typingOther   bool
+	otherAnswer   string
+	otherValue    string
+	otherErr      error
+	// the user's own answer the prompt starts with, given by SetDefault
+	defaultOther string
+	// the defaults are looked for again once the options are loaded
+	pendingDefault bool
 }
 
 // data available to the templates when processing
@@ -46,6 +77,12 @@ type MultiSelectTemplateData struct {
 	ShowHelp      bool
 	Description   func(value string, index int) string
 	PageEntries   []core.OptionAnswer
+	Loading       bool
+	LoadError     error
+	TypingOther   bool
+	OtherAnswer   string
+	OtherError    error
+	Hotkeys       map[int]rune
 	Config        *PromptConfig
 
 	// These fields are used when rendering an individual option
@@ -68,20 +105,61 @@ func (m MultiSelectTemplateData) GetDescription(opt core.OptionAnswer) string {
 	return m.Description(opt.Value, opt.Index)
 }
 
+// GetLabel returns how an option is shown
+func (m MultiSelectTemplateData) GetLabel(opt core.OptionAnswer) string {
+	return choiceLabel(m.choices, opt)
+}
+
+// IsGroup returns whether the entry is the header of a group
+func (m MultiSelectTemplateData) IsGroup(opt core.OptionAnswer) bool {
+	return opt.Index == groupIndex
+}
+
+// IsDisabled returns whether the option can't be checked or unchecked
+func (m MultiSelectTemplateData) IsDisabled(opt core.OptionAnswer) bool {
+	return choiceAt(m.choices, opt.Index).Disabled
+}
+
+// GetDisabledReason returns why the option can't be checked or unchecked
+func (m MultiSelectTemplateData) GetDisabledReason(opt core.OptionAnswer) string {
+	return choiceAt(m.choices, opt.Index).DisabledReason
+}
+
+// GetHotkey returns the key that checks or unchecks the option, if it has one
+func (m MultiSelectTemplateData) GetHotkey(opt core.OptionAnswer) string {
+	if key, ok := m.Hotkeys[opt.Index]; ok && opt.Index >= 0 {
+		return string(key)
+	}
+	return ""
+}
+
 var MultiSelectQuestionTemplate = `
 {{- define "option"}}
+  {{- if .IsGroup .CurrentOpt }}{{color "default+hb"}}{{ .CurrentOpt.Value }}{{color "reset"}}
+  {{- else}}
     {{- if eq .SelectedIndex .CurrentIndex }}{{color .Config.Icons.SelectFocus.Format }}{{ .Config.Icons.SelectFocus.Text }}{{color "reset"}}{{else}} {{end}}
     {{- if index .Checked .CurrentOpt.Index }}{{color .Config.Icons.MarkedOption.Format }} {{ .Config.Icons.MarkedOption.Text }} {{else}}{{color .Config.Icons.UnmarkedOption.Format }} {{ .Config.Icons.UnmarkedOption.Text }} {{end}}
     {{- color "reset"}}
-    {{- " "}}{{- .CurrentOpt.Value}}{{ if ne ($.GetDescription .CurrentOpt) "" }} - {{color "cyan"}}{{ $.GetDescription .CurrentOpt }}{{color "reset"}}{{end}}
+    {{- " "}}
+    {{- with .GetHotkey .CurrentOpt }}{{ . }}) {{end}}
+    {{- if .IsDisabled .CurrentOpt }}{{color "black+h"}}{{ .GetLabel .CurrentOpt }}{{ if .GetDisabledReason .CurrentOpt }} ({{ .GetDisabledReason .CurrentOpt }}){{end}}{{color "reset"}}
+    {{- else}}{{ .GetLabel .CurrentOpt }}{{ if ne ($.GetDescription .CurrentOpt) "" }} - {{color "cyan"}}{{ $.GetDescription .CurrentOpt }}{{color "reset"}}{{end}}{{end}}
+  {{- end}}
 {{end}}
 {{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
 {{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
 {{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
 {{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else if .TypingOther}}
+  {{- "  "}}{{- color "cyan"}}[Type your answer, enter to submit, esc to go back to the options]{{color "reset"}}
+  {{- "\n"}}
+  {{- if .OtherError}}{{color .Config.Icons.Error.Format }}{{ .Config.Icons.Error.Text }} {{ .OtherError }}{{color "reset"}}{{"\n"}}{{end}}
+  {{- color .Config.Icons.SelectFocus.Format }}{{ .Config.Icons.SelectFocus.Text }} {{color "reset"}}{{ .Other }} {{color "cyan+b"}}{{ .OtherAnswer }}_{{color "reset"}}{{"\n"}}
 {{- else }}
-	{{- "  "}}{{- color "cyan"}}[Use arrows to move, space to select,{{- if not .Config.RemoveSelectAll }} <right> to all,{{end}}{{- if not .Config.RemoveSelectNone }} <left> to none,{{end}} type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+	{{- "  "}}{{- color "cyan"}}[Use arrows to move, space to select,{{- if .Config.Hotkeys }} press a key to toggle,{{end}}{{- if not .Config.RemoveSelectAll }} <right> to all,{{end}}{{- if not .Config.RemoveSelectNone }} <left> to none,{{end}} type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
   {{- "\n"}}
+  {{- if .Loading}}{{"  "}}{{color "cyan"}}Loading options...{{color "reset"}}{{"\n"}}{{end}}
+  {{- if .LoadError}}{{color .Config.Icons.Error.Format }}{{ .Config.Icons.Error.Text }} Unable to load the options: {{ .LoadError }}{{color "reset"}}{{"\n"}}{{end}}
   {{- range $ix, $option := .PageEntries}}
     {{- template "option" $.IterateOption $ix $option}}
   {{- end}}
@@ -89,10 +167,15 @@ var MultiSelectQuestionTemplate = `
 
 // OnChange is called on every keypress.
 func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
+	if m.typingOther {
+		m.onOtherKey(key, config)
+		return
+	}
+
 	options := m.filterOptions(config)
 	oldFilter := m.filter
 
-	if key == terminal.KeyArrowUp || (m.VimMode && key == 'k') {
+	if (key == terminal.KeyArrowUp || (m.VimMode && key == 'k')) && len(options) > 0 {
 		// if we are at the top of the list
 		if m.selectedIndex == 0 {
 			// go to the bottom
@@ -101,7 +184,9 @@ func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
 			// decrement the selected index
 			m.selectedIndex--
 		}
-	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (m.VimMode && key == 'j') {
+		// skip the headers and the options that can't be checked
+		m.selectedIndex = nextChoice(m.choices, options, m.selectedIndex, -1)
+	} else if (key == terminal.KeyTab || key == terminal.KeyArrowDown || (m.VimMode && key == 'j')) && len(options) > 0 {
 		// if we are at the bottom of the list
 		if m.selectedIndex == len(options)-1 {
 			// start at the top
@@ -110,14 +195,18 @@ func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
 			// increment the selected index
 			m.selectedIndex++
 		}
+		m.selectedIndex = nextChoice(m.choices, options, m.selectedIndex, 1)
 		// if the user pressed down and there is room to move
 	} else if key == terminal.KeySpace {
 		// the option they have selected
-		if m.selectedIndex < len(options) {
+		if m.selectedIndex < len(options) && canChoose(m.choices, options[m.selectedIndex]) {
 			selectedOpt := options[m.selectedIndex]
 
-			// if we haven't seen this index before
-			if old, ok := m.checked[selectedOpt.Index]; !ok {
+			// the user wants to type their own answer, starting from the one they typed before
+			if selectedOpt.Index == core.OtherIndex {
+				m.typingOther = true
+				m.otherAnswer = m.otherValue
+			} else if old, ok := m.checked[selectedOpt.Index]; !ok {
 				// set the value to true
 				m.checked[selectedOpt.Index] = true
 			} else {
@@ -128,6 +217,19 @@ func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
 				m.filter = ""
 			}
 		}
+		// if the user wants to move a page at a time
+	} else if (key == terminal.SpecialKeyPageUp || key == terminal.SpecialKeyPageDown) && len(options) > 0 {
+		delta := m.pageSize(config)
+		if key == terminal.SpecialKeyPageUp {
+			delta = -delta
+		}
+		// pages stop at the ends of the list instead of going around
+		m.selectedIndex = moveBy(m.choices, options, m.selectedIndex, delta)
+		// if the user wants to go to the first or the last option
+	} else if key == terminal.SpecialKeyHome && len(options) > 0 {
+		m.selectedIndex = moveBy(m.choices, options, m.selectedIndex, -len(options))
+	} else if key == terminal.SpecialKeyEnd && len(options) > 0 {
+		m.selectedIndex = moveBy(m.choices, options, m.selectedIndex, len(options))
 		// only show the help message if we have one to show
 	} else if string(key) == config.HelpInput && m.Help != "" {
 		m.showingHelp = true
@@ -140,19 +242,28 @@ func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
 			runeFilter := []rune(m.filter)
 			m.filter = string(runeFilter[0 : len(runeFilter)-1])
 		}
-	} else if key >= terminal.KeySpace {
+	} else if index, ok := m.hotkey(key, config); ok {
+		// the user checked or unchecked an option with its key
+		m.selectedIndex = index
+		m.checked[options[index].Index] = !m.checked[options[index].Index]
+	} else if terminal.IsTyped(key) {
 		m.filter += string(key)
 		m.VimMode = false
 	} else if !config.RemoveSelectAll && key == terminal.KeyArrowRight {
 		for _, v := range options {
-			m.checked[v.Index] = true
+			// the user's own answer has to be typed
+			if v.Index != core.OtherIndex && canChoose(m.choices, v) {
+				m.checked[v.Index] = true
+			}
 		}
 		if !config.KeepFilter {
 			m.filter = ""
 		}
 	} else if !config.RemoveSelectNone && key == terminal.KeyArrowLeft {
 		for _, v := range options {
-			m.checked[v.Index] = false
+			if canChoose(m.choices, v) {
+				m.checked[v.Index] = false
+			}
 		}
 		if !config.KeepFilter {
 			m.filter = ""
@@ -169,20 +280,77 @@ func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
 		if len(options) > 0 && len(options) <= m.selectedIndex {
 			m.selectedIndex = len(options) - 1
 		}
+		m.selectedIndex = nextChoice(m.choices, options, m.selectedIndex, 1)
+		// ask for the options that go with the new filter
+		m.loader.refresh(m.filter)
 	}
-	// paginate the options
-	// figure out the page size
-	pageSize := m.PageSize
+
+	// render the options
+	_ = m.render(config)
+}
+
+// onOtherKey handles the keys pressed while the user types their own answer
+func (m *MultiSelect) onOtherKey(key rune, config *PromptConfig) {
+	if key == terminal.KeyEnter || key == '\n' {
+		// an empty answer takes the one they typed before away
+		m.otherErr = nil
+		if strings.TrimSpace(m.otherAnswer) != "" {
+			m.otherErr = checkOther(m.otherAnswer, m.ValidateOther)
+		}
+		if m.otherErr == nil {
+			m.otherValue = strings.TrimSpace(m.otherAnswer)
+			m.checked[core.OtherIndex] = m.otherValue != ""
+			m.typingOther = false
+		}
+	} else if key == terminal.KeyEscape {
+		// go back to the options
+		m.typingOther = false
+		m.otherErr = nil
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		m.otherAnswer = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if m.otherAnswer != "" {
+			runeAnswer := []rune(m.otherAnswer)
+			m.otherAnswer = string(runeAnswer[0 : len(runeAnswer)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		m.otherAnswer += string(key)
+	}
+
+	_ = m.render(config)
+}
+
+// hotkey returns where the option checked or unchecked by the key is listed, if the key picks one.
+// Keys only pick options until the user starts to filter them.
+func (m *MultiSelect) hotkey(key rune, config *PromptConfig) (int, bool) {
+	if !config.Hotkeys || m.filter != "" {
+		return 0, false
+	}
+	return hotkeyEntry(m.choices, m.filterOptions(config), hotkeys(m.choices, m.options), key)
+}
+
+// pageSize returns how many options are shown at a time
+func (m *MultiSelect) pageSize(config *PromptConfig) int {
 	// if we dont have a specific one
-	if pageSize == 0 {
+	if m.PageSize == 0 {
 		// grab the global value
-		pageSize = config.PageSize
+		return config.PageSize
 	}
+	return m.PageSize
+}
 
+// render shows the page of the options with the selected one
+func (m *MultiSelect) render(config *PromptConfig) error {
 	// TODO if we have started filtering and were looking at the end of a list
 	// and we have modified the filter then we should move the page back!
-	opts, idx := paginate(pageSize, options, m.selectedIndex)
+	// paginate the options
+	opts, idx := paginate(m.pageSize(config), m.filterOptions(config), m.selectedIndex)
+	if m.typingOther {
+		// the options are hidden while the user types
+		opts, idx = nil, 0
+	}
 
+	loading, loadErr := m.loader.status()
 	tmplData := MultiSelectTemplateData{
 		MultiSelect:   *m,
 		SelectedIndex: idx,
@@ -190,11 +358,94 @@ func (m *MultiSelect) OnChange(key rune, config *PromptConfig) {
 		ShowHelp:      m.showingHelp,
 		Description:   m.Description,
 		PageEntries:   opts,
+		Loading:       loading,
+		LoadError:     loadErr,
+		TypingOther:   m.typingOther,
+		OtherAnswer:   m.otherAnswer,
+		OtherError:    m.otherErr,
 		Config:        config,
 	}
+	if config.Hotkeys {
+		tmplData.Hotkeys = hotkeys(m.choices, m.options)
+	}
 
-	// render the options
-	_ = m.RenderWithCursorOffset(MultiSelectQuestionTemplate, tmplData, opts, idx)
+	return m.RenderWithCursorOffset(MultiSelectQuestionTemplate, tmplData, opts, idx)
+}
+
+// loaded replaces the options with the ones that were loaded, keeping the checked options and
+// the same option selected
+func (m *MultiSelect) loaded(config *PromptConfig, options []string, err error) {
+	if err == nil {
+		selected := ""
+		if current := m.filterOptions(config); m.selectedIndex < len(current) {
+			selected = current[m.selectedIndex].Value
+		}
+
+		values := append([]string{}, options...)
+		checked := map[int]bool{}
+		for _, ans := range m.checkedAnswers(m.checked) {
+			if ans.Index == core.OtherIndex {
+				checked[core.OtherIndex] = true
+				continue
+			}
+			index := -1
+			for i, value := range values {
+				if value == ans.Value {
+					index = i
+					break
+				}
+			}
+			// the checked options that weren't loaded again go at the end
+			if index == -1 {
+				values = append(values, ans.Value)
+				index = len(values) - 1
+			}
+			checked[index] = true
+		}
+		m.options = values
+		// the loaded options have nothing more to them than their values
+		m.choices = nil
+		m.checked = checked
+
+		m.selectedIndex = 0
+		for i, opt := range m.filterOptions(config) {
+			if opt.Value == selected {
+				m.selectedIndex = i
+				break
+			}
+		}
+	}
+
+	// the defaults can only be found among the options that were loaded
+	if m.pendingDefault {
+		m.pendingDefault = false
+		for i := range m.defaultChecked() {
+			m.checked[i] = true
+		}
+	}
+
+	_ = m.render(config)
+}
+
+// start shows the options the prompt was given, until the loaded ones replace them
+func (m *MultiSelect) start() {
+	m.options = optionValues(m.Choices, m.Options)
+	m.choices = m.Choices
+}
+
+// fetchOptions loads the options right away for when the prompt can't show them as they load
+func (m *MultiSelect) fetchOptions() error {
+	m.start()
+	if m.LoadOptions == nil {
+		return nil
+	}
+	options, err := m.LoadOptions(context.Background(), "")
+	if err != nil {
+		return fmt.Errorf("unable to load the options: %w", err)
+	}
+	m.options = options
+	m.choices = nil
+	return nil
 }
 
 func (m *MultiSelect) filterOptions(config *PromptConfig) []core.OptionAnswer {
@@ -204,7 +455,7 @@ func (m *MultiSelect) filterOptions(config *PromptConfig) []core.OptionAnswer {
 	// if there is no filter applied
 	if m.filter == "" {
 		// return all of the options
-		return core.OptionAnswerList(m.Options)
+		return withOther(withGroups(m.choices, core.OptionAnswerList(m.options)), m.otherEntry())
 	}
 
 	// the filter to apply
@@ -214,9 +465,9 @@ func (m *MultiSelect) filterOptions(config *PromptConfig) []core.OptionAnswer {
 	}
 
 	// apply the filter to each option
-	for i, opt := range m.Options {
+	for i, opt := range m.options {
 		// i the filter says to include the option
-		if filter(m.filter, opt, i) {
+		if filter(m.filter, choiceLabel(m.choices, core.OptionAnswer{Value: opt, Index: i}), i) {
 			answers = append(answers, core.OptionAnswer{
 				Index: i,
 				Value: opt,
@@ -225,22 +476,38 @@ func (m *MultiSelect) filterOptions(config *PromptConfig) []core.OptionAnswer {
 	}
 
 	// we're done here
-	return answers
+	return withOther(withGroups(m.choices, answers), m.otherEntry())
 }
 
-func (m *MultiSelect) Prompt(config *PromptConfig) (interface{}, error) {
-	// compute the default state
-	m.checked = make(map[int]bool)
+func (m *MultiSelect) optionLabel(ans core.OptionAnswer) string {
+	// the loaded options replace the choices the answer could have come from
+	if m.LoadOptions != nil {
+		return choiceLabel(m.choices, ans)
+	}
+	return choiceLabel(m.Choices, ans)
+}
+
+// otherEntry returns how the entry for the user's own answer is shown, along with that answer
+func (m *MultiSelect) otherEntry() string {
+	if m.Other == "" || m.otherValue == "" {
+		return m.Other
+	}
+	return m.Other + " " + m.otherValue
+}
+
+// defaultChecked returns the indices of the options that are checked when the prompt starts.
+func (m *MultiSelect) defaultChecked() map[int]bool {
+	checked := make(map[int]bool)
 	// if there is a default
 	if m.Default != nil {
 		// if the default is string values
 		if defaultValues, ok := m.Default.([]string); ok {
 			for _, dflt := range defaultValues {
-				for i, opt := range m.Options {
+				for i, opt := range m.options {
 					// if the option corresponds to the default
 					if opt == dflt {
 						// we found our initial value
-						m.checked[i] = true
+						checked[i] = true
 						// stop looking
 						break
 					}
@@ -251,27 +518,47 @@ func (m *MultiSelect) Prompt(config *PromptConfig) (interface{}, error) {
 			// go over every index we need to enable by default
 			for _, idx := range defaultIndices {
 				// and enable it
-				m.checked[idx] = true
+				checked[idx] = true
 			}
 		}
 	}
+	// the user's own answer is checked again when they come back to the prompt
+	if m.Other != "" && m.defaultOther != "" {
+		checked[core.OtherIndex] = true
+	}
+	return checked
+}
+
+func (m *MultiSelect) Prompt(config *PromptConfig) (interface{}, error) {
+	m.start()
+
+	// the options are loaded while the prompt is shown when there is a terminal
+	loading := m.LoadOptions != nil && m.interactive()
+	if !loading {
+		if err := m.fetchOptions(); err != nil {
+			return "", err
+		}
+	}
+
+	// compute the default state
+	m.checked = m.defaultChecked()
+	m.pendingDefault = loading && m.Default != nil
+	m.typingOther = false
+	m.otherAnswer = ""
+	m.otherValue = m.defaultOther
+	m.otherErr = nil
 
 	// if there are no options to render
-	if len(m.Options) == 0 {
+	if len(m.options) == 0 && !loading {
 		// we failed
 		return "", errors.New("please provide options to select from")
 	}
 
-	// figure out the page size
-	pageSize := m.PageSize
-	// if we dont have a specific one
-	if pageSize == 0 {
-		// grab the global value
-		pageSize = config.PageSize
+	if !m.interactive() {
+		return m.promptPlain(config)
 	}
-	// paginate the options
-	// build up a list of option answers
-	opts, idx := paginate(pageSize, core.OptionAnswerList(m.Options), m.selectedIndex)
+	// start on the first option that can be checked
+	m.selectedIndex = nextChoice(m.choices, m.filterOptions(config), 0, 1)
 
 	cursor := m.NewCursor()
 	cursor.Save()          // for proper cursor placement during selection
@@ -279,17 +566,22 @@ func (m *MultiSelect) Prompt(config *PromptConfig) (interface{}, error) {
 	defer cursor.Show()    // show the cursor when we're done
 	defer cursor.Restore() // clear any accessibility offsetting on exit
 
-	tmplData := MultiSelectTemplateData{
-		MultiSelect:   *m,
-		SelectedIndex: idx,
-		Description:   m.Description,
-		Checked:       m.checked,
-		PageEntries:   opts,
-		Config:        config,
+	m.loader = nil
+	if loading {
+		m.loader = newOptionLoader(m.LoadOptions, func(options []string, err error) {
+			m.loaded(config, options, err)
+		})
+		// drop any results that come in once we're done
+		defer m.loader.stop()
 	}
 
-	// ask the question
-	err := m.RenderWithCursorOffset(MultiSelectQuestionTemplate, tmplData, opts, idx)
+	// ask the question while the options start to load
+	m.loader.lock()
+	if loading {
+		m.loader.request("", 0)
+	}
+	err := m.render(config)
+	m.loader.unlock()
 	if err != nil {
 		return "", err
 	}
@@ -306,36 +598,205 @@ func (m *MultiSelect) Prompt(config *PromptConfig) (interface{}, error) {
 		if err != nil {
 			return "", err
 		}
-		if r == '\r' || r == '\n' {
+		if (r == '\r' || r == '\n') && !m.typingOther {
+			m.loader.lock()
+			pending := m.pendingDefault
+			m.loader.unlock()
+			// the defaults aren't checked yet
+			if pending {
+				continue
+			}
 			break
 		}
 		if r == terminal.KeyInterrupt {
 			return "", terminal.InterruptErr
 		}
+		if config.BackKey != 0 && r == config.BackKey {
+			m.loader.stop()
+			m.filter = ""
+			m.FilterMessage = ""
+			return "", ErrGoBack
+		}
 		if r == terminal.KeyEndTransmission {
 			break
 		}
+		m.loader.lock()
 		m.OnChange(r, config)
+		m.loader.unlock()
 	}
+	m.loader.stop()
 	m.filter = ""
 	m.FilterMessage = ""
 
+	return m.checkedAnswers(m.checked), nil
+}
+
+// checkedAnswers returns the answer for the given set of checked options.
+func (m *MultiSelect) checkedAnswers(checked map[int]bool) []core.OptionAnswer {
 	answers := []core.OptionAnswer{}
-	for i, option := range m.Options {
-		if val, ok := m.checked[i]; ok && val {
-			answers = append(answers, core.OptionAnswer{Value: option, Index: i})
+	for i, option := range m.options {
+		if val, ok := checked[i]; ok && val {
+			answers = append(answers, choiceAnswer(m.choices, core.OptionAnswer{Value: option, Index: i}))
 		}
 	}
+	// the user's own answer comes after the options like its entry
+	if checked[core.OtherIndex] && m.otherValue != "" {
+		answers = append(answers, core.OptionAnswer{Value: m.otherValue, Index: core.OtherIndex})
+	}
+	return answers
+}
+
+// promptPlain lists the numbered options and reads a line with the numbers or values of the
+// options to check, separated by commas.
+func (m *MultiSelect) promptPlain(config *PromptConfig) (interface{}, error) {
+	defaults := m.checkedAnswers(m.checked)
+	defaultValues := []string{}
+	for _, ans := range defaults {
+		defaultValues = append(defaultValues, ans.Value)
+	}
+
+	instructions := "Enter numbers or values separated by commas"
+	if m.Other != "" {
+		instructions = "Enter numbers, values or your own answer separated by commas"
+	}
 
+	return m.askPlain(config, PlainTemplateData{
+		Message:      m.Message,
+		Help:         m.Help,
+		Options:      plainChoices(m.choices, m.options),
+		Instructions: instructions,
+		Default:      strings.Join(defaultValues, ", "),
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return defaults, nil
+		}
+
+		// the disabled options that are checked stay checked
+		checked := map[int]bool{}
+		for _, ans := range defaults {
+			if !canChoose(m.choices, ans) {
+				checked[ans.Index] = true
+			}
+		}
+		other := ""
+		for _, item := range strings.Split(line, ",") {
+			ans, err := parsePlainOption(m.options, item)
+			// the first item that isn't an option can be the user's own answer
+			if err != nil && m.Other != "" && other == "" {
+				ans, err = typedOther(item, m.ValidateOther)
+				other = ans.Value
+			} else if err == nil {
+				err = checkChoice(m.choices, ans)
+			}
+			if err != nil {
+				return nil, err
+			}
+			checked[ans.Index] = true
+		}
+		m.otherValue = other
+		return m.checkedAnswers(checked), nil
+	})
+}
+
+// parseAnswer checks the options with the values given by an AnswerSource, either as a list or
+// separated by commas. The first value that isn't an option can be the user's own answer.
+func (m *MultiSelect) parseAnswer(value interface{}) (interface{}, error) {
+	if err := m.fetchOptions(); err != nil {
+		return nil, err
+	}
+	values, err := sourceList(value, "a multiselect")
+	if err != nil {
+		return nil, err
+	}
+
+	answers := []core.OptionAnswer{}
+	other := false
+	for _, str := range values {
+		ans, err := findOption(m.options, strings.TrimSpace(str))
+		if err != nil && m.Other != "" && !other {
+			ans, err = typedOther(str, m.ValidateOther)
+			other = true
+		} else if err == nil {
+			err = checkChoice(m.choices, ans)
+		}
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, choiceAnswer(m.choices, ans))
+	}
 	return answers, nil
 }
 
+// defaultAnswer returns the options that are checked when the prompt starts.
+func (m *MultiSelect) defaultAnswer() (interface{}, error) {
+	if err := m.fetchOptions(); err != nil {
+		return nil, err
+	}
+	m.otherValue = m.defaultOther
+	return m.checkedAnswers(m.defaultChecked()), nil
+}
+
+// SetDefault uses the given answer as the default selection. It accepts a list of
+// OptionAnswers, option values or option indices. An OptionAnswer with core.OtherIndex
+// checks the Other entry with the answer the user typed.
+func (m *MultiSelect) SetDefault(value interface{}) error {
+	options := optionValues(m.Choices, m.Options)
+	switch v := value.(type) {
+	case []core.OptionAnswer:
+		other := ""
+		values := []string{}
+		indices := []int{}
+		for _, ans := range v {
+			if ans.Index == core.OtherIndex {
+				other = ans.Value
+				continue
+			}
+			values = append(values, ans.Value)
+			indices = append(indices, ans.Index)
+		}
+		if other != "" && m.Other == "" {
+			return fmt.Errorf("cannot use %q as a default without an Other entry", other)
+		}
+
+		var err error
+		// the options might be loaded in another order, so look for the values once they are
+		if m.LoadOptions != nil {
+			err = m.SetDefault(values)
+		} else {
+			err = m.SetDefault(indices)
+		}
+		if err != nil {
+			return err
+		}
+		m.defaultOther = other
+		return nil
+	case []string:
+		m.Default = v
+		m.defaultOther = ""
+		return nil
+	case []int:
+		for _, idx := range v {
+			if idx < 0 || idx >= len(options) {
+				return fmt.Errorf("default index %d exceeds the number of options", idx)
+			}
+		}
+		m.Default = v
+		m.defaultOther = ""
+		return nil
+	}
+	return fmt.Errorf("cannot use %T as the default of a multiselect", value)
+}
+
 // Cleanup removes the options section, and renders the ask like a normal question.
 func (m *MultiSelect) Cleanup(config *PromptConfig, val interface{}) error {
+	if !m.interactive() {
+		return nil
+	}
+
 	// the answer to show
 	answer := ""
 	for _, ans := range val.([]core.OptionAnswer) {
-		answer = fmt.Sprintf("%s, %s", answer, ans.Value)
+		answer = fmt.Sprintf("%s, %s", answer, choiceLabel(m.choices, ans))
 	}
 
 	// if we answered anything
@@ -2,6 +2,7 @@ package survey
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -762,3 +763,292 @@ func TestMultiSelectPromptRemoveSelectNone(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiSelectSetDefault(t *testing.T) {
+	prompt := &MultiSelect{
+		Options: []string{"red", "blue", "green"},
+	}
+
+	assert.NoError(t, prompt.SetDefault([]core.OptionAnswer{{Value: "red", Index: 0}, {Value: "green", Index: 2}}))
+	assert.Equal(t, []int{0, 2}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault([]string{"blue"}))
+	assert.Equal(t, []string{"blue"}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault([]int{5}))
+	assert.Error(t, prompt.SetDefault("blue"))
+	assert.Equal(t, []string{"blue"}, prompt.Default)
+}
+
+func TestMultiSelectSetDefaultOther(t *testing.T) {
+	prompt := &MultiSelect{
+		Options: []string{"chess", "reading"},
+		Other:   "Other:",
+	}
+
+	dflt := []core.OptionAnswer{{Value: "chess", Index: 0}, {Value: "hiking", Index: core.OtherIndex}}
+	assert.NoError(t, prompt.SetDefault(dflt))
+	assert.Equal(t, []int{0}, prompt.Default)
+
+	ans, err := prompt.defaultAnswer()
+	assert.NoError(t, err)
+	assert.Equal(t, dflt, ans)
+
+	prompt.Other = ""
+	assert.Error(t, prompt.SetDefault(dflt))
+}
+
+func TestMultiSelectLoadOptions(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"keep the checked options",
+			&MultiSelect{
+				Message: "Choose branches:",
+				LoadOptions: func(ctx context.Context, filter string) ([]string, error) {
+					if filter == "" {
+						return []string{"main", "develop"}, nil
+					}
+					return branchLoader(ctx, filter)
+				},
+				Default: []string{"main"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("develop")
+				c.Send("feature")
+				c.ExpectString("feature-search")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				// the filter is gone so the first options are loaded again
+				c.ExpectString("develop")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "main", Index: 0}, {Value: "feature-search", Index: 2}},
+		},
+		func() PromptTest {
+			release := make(chan struct{})
+			return PromptTest{
+				"wait for the defaults to load",
+				&MultiSelect{
+					Message: "Choose branches:",
+					Help:    "The branches to merge",
+					LoadOptions: func(ctx context.Context, filter string) ([]string, error) {
+						<-release
+						return branchLoader(ctx, filter)
+					},
+					Default: []string{"feature-login"},
+				},
+				func(c expectConsole) {
+					c.ExpectString("Loading options...")
+					// enter does nothing until the defaults are found
+					c.SendLine("")
+					c.Send("?")
+					c.ExpectString("The branches to merge")
+					close(release)
+					c.ExpectString("feature-search")
+					c.SendLine("")
+					c.ExpectEOF()
+				},
+				[]core.OptionAnswer{{Value: "feature-login", Index: 2}},
+			}
+		}(),
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestMultiSelectLoadOptionsKeepsOptions(t *testing.T) {
+	prompt := &MultiSelect{Options: []string{"main"}, LoadOptions: branchLoader}
+
+	ans, err := prompt.parseAnswer("develop,feature-login")
+	assert.NoError(t, err)
+	assert.Equal(t, []core.OptionAnswer{{Value: "develop", Index: 1}, {Value: "feature-login", Index: 2}}, ans)
+
+	// the loaded options are only shown, the ones the prompt was given stay for the next time
+	assert.Equal(t, []string{"main"}, prompt.Options)
+
+	// the defaults are found by their values once the options are loaded again
+	assert.NoError(t, prompt.SetDefault(ans))
+	assert.Equal(t, []string{"develop", "feature-login"}, prompt.Default)
+}
+
+func TestMultiSelectOther(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"add an answer",
+			&MultiSelect{
+				Message: "Hobbies:",
+				Options: []string{"chess", "reading"},
+				Other:   "Other:",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Other:")
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(" ")
+				c.ExpectString("Type your answer")
+				c.Send("hiking")
+				c.SendLine("")
+				c.ExpectString("Other: hiking")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "chess", Index: 0}, {Value: "hiking", Index: core.OtherIndex}},
+		},
+		{
+			"take the answer away",
+			&MultiSelect{
+				Message: "Hobbies:",
+				Options: []string{"chess", "reading"},
+				Other:   "Other:",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Other:")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(" ")
+				c.Send("hiking")
+				c.SendLine("")
+				c.ExpectString("Other: hiking")
+				c.Send(" ")
+				c.Send(string(terminal.KeyDeleteWord))
+				c.SendLine("")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "chess", Index: 0}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestMultiSelectChoices(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"disabled options can't be checked",
+			&MultiSelect{
+				Message: "Deploy to:",
+				Choices: environmentChoices(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Deploy to:")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "dev", Index: 0}, {Value: "demo", Index: 3}},
+		},
+		{
+			"select all and none leave the disabled options alone",
+			&MultiSelect{
+				Message: "Deploy to:",
+				Choices: environmentChoices(),
+				Default: []string{"prod"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Deploy to:")
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowLeft))
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "dev", Index: 0}, {Value: "staging", Index: 1}, {Value: "prod", Index: 2}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestMultiSelect_WriteData(t *testing.T) {
+	answers := struct {
+		Envs []environment
+		IDs  []int
+	}{}
+
+	prompt := &MultiSelect{Choices: []Option{{Value: "dev", Data: 12}, {Value: "staging", Data: 3}, {Value: "prod", Data: 7}}}
+	err := Ask([]*Question{
+		{Name: "envs", Prompt: &MultiSelect{Choices: deployTargets()}},
+		{Name: "ids", Prompt: prompt},
+	}, &answers, WithAnswerSource(MapSource{
+		"envs": "dev,prod",
+		"ids":  []string{"prod", "staging"},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []environment{{Name: "dev", ID: 12}, {Name: "prod", ID: 7}}, answers.Envs)
+	assert.Equal(t, []int{7, 3}, answers.IDs)
+
+	// the data in the response is used to find the defaults
+	err = Ask([]*Question{{Name: "ids", Prompt: prompt}}, &answers, WithAnswerSource(MapSource{}), WithDefaultsFromResponse())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 1}, prompt.Default)
+	assert.Equal(t, []int{3, 7}, answers.IDs)
+}
+
+func TestMultiSelectHotkeys(t *testing.T) {
+	RunPromptTestHotkeys(t, PromptTest{
+		"keys check and uncheck the options",
+		&MultiSelect{
+			Message: "What colors do you prefer:",
+			Options: []string{"red", "blue", "green"},
+		},
+		func(c expectConsole) {
+			c.ExpectString("[Use arrows to move, space to select, press a key to toggle,")
+			c.Send("1")
+			c.Send("3")
+			c.Send("1")
+			c.Send("2")
+			c.ExpectString("2) blue")
+			c.SendLine("")
+			c.ExpectEOF()
+		},
+		[]core.OptionAnswer{{Value: "blue", Index: 1}, {Value: "green", Index: 2}},
+	})
+}
+
+func TestMultiSelectPageNavigation(t *testing.T) {
+	RunPromptTest(t, PromptTest{
+		"page down, end and home",
+		&MultiSelect{
+			Message:  "Choose options:",
+			Options:  numberedOptions(20),
+			PageSize: 5,
+		},
+		func(c expectConsole) {
+			c.ExpectString("Choose options:")
+			c.Send(pageDownSequence)
+			c.Send(" ")
+			c.Send(endSequence)
+			c.Send(" ")
+			c.Send(homeSequence)
+			c.Send(" ")
+			c.SendLine("")
+			c.ExpectEOF()
+		},
+		[]core.OptionAnswer{{Value: "option-0", Index: 0}, {Value: "option-5", Index: 5}, {Value: "option-19", Index: 19}},
+	})
+}
@@ -0,0 +1,381 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+MultiTableSelect is a prompt that presents rows of cells under a line of headers for the user to
+select using the arrow keys, space and enter. The left and right arrows sort the rows by each
+column in turn, and typing filters the rows whose cells match. Response type is a slice of
+core.OptionAnswer with the cell of every selected row in ValueColumn and the index of the row in
+Rows. Default is either a slice of those cells or a slice of row indices.
+
+	instances := []string{}
+	prompt := &survey.MultiTableSelect{
+		Message: "Which instances should be stopped?",
+		Headers: []string{"NAME", "REGION", "SIZE"},
+		Rows: [][]string{
+			{"web-1", "us-east-1", "t3.small"},
+			{"db-1", "eu-west-1", "r5.large"},
+		},
+	}
+	survey.AskOne(prompt, &instances)
+*/
+type MultiTableSelect struct {
+	Renderer
+	Message       string
+	Headers       []string
+	Rows          [][]string
+	ValueColumn   int
+	Default       interface{}
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	Filter        func(filter string, value string, index int) bool
+	table         *table
+	filter        string
+	checked       map[int]bool
+	showingHelp   bool
+}
+
+// MultiTableSelectTemplateData is the data available to the templates when processing
+type MultiTableSelectTemplateData struct {
+	MultiTableSelect
+	Header        string
+	PageEntries   []TableRow
+	SelectedIndex int
+	Checked       map[int]bool
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+	Config        *PromptConfig
+}
+
+var MultiTableSelectQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, space to select, left and right to sort, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- if .Header}}{{"     "}}{{color "default+hb"}}{{ .Header }}{{color "reset"}}{{"\n"}}{{end}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- if eq $.SelectedIndex $ix }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }}{{color "reset"}}{{else}} {{end}}
+    {{- if index $.Checked $row.Index }}{{color $.Config.Icons.MarkedOption.Format }} {{ $.Config.Icons.MarkedOption.Text }} {{else}}{{color $.Config.Icons.UnmarkedOption.Format }} {{ $.Config.Icons.UnmarkedOption.Text }} {{end}}
+    {{- color "reset"}}
+    {{- " "}}{{- $row.Line}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// match returns the filter used to find the rows the user is looking for
+func (m *MultiTableSelect) match(config *PromptConfig) func(filter string, value string, index int) bool {
+	if m.Filter != nil {
+		return m.Filter
+	}
+	return config.Filter
+}
+
+// OnChange is called on every keypress.
+func (m *MultiTableSelect) OnChange(key rune, config *PromptConfig) {
+	visible := m.table.visible(m.filter, m.match(config))
+
+	if key == terminal.KeyArrowUp || (m.VimMode && key == 'k') {
+		m.table.move(visible, -1)
+	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (m.VimMode && key == 'j') {
+		m.table.move(visible, 1)
+	} else if key == terminal.KeyArrowRight || (m.VimMode && key == 'l') {
+		m.table.cycleSort(1)
+	} else if key == terminal.KeyArrowLeft || (m.VimMode && key == 'h') {
+		m.table.cycleSort(-1)
+	} else if key == terminal.KeySpace {
+		if len(visible) > 0 {
+			m.table.position(visible)
+			m.checked[m.table.focus] = !m.checked[m.table.focus]
+			if !config.KeepFilter {
+				m.filter = ""
+			}
+		}
+	} else if string(key) == config.HelpInput && m.Help != "" {
+		m.showingHelp = true
+	} else if key == terminal.KeyEscape {
+		m.VimMode = !m.VimMode
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		m.filter = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if m.filter != "" {
+			runeFilter := []rune(m.filter)
+			m.filter = string(runeFilter[0 : len(runeFilter)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		m.filter += string(key)
+		m.VimMode = false
+	}
+
+	m.FilterMessage = ""
+	if m.filter != "" {
+		m.FilterMessage = " " + m.filter
+	}
+
+	_ = m.render(config)
+}
+
+// render shows the headers and the page of the rows with the focused one
+func (m *MultiTableSelect) render(config *PromptConfig) error {
+	pageSize := m.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	// the rows start after the focus marker and the checkbox and stop before the edge of the
+	// terminal
+	width := m.termWidthSafe() - 6
+
+	visible := m.table.visible(m.filter, m.match(config))
+	rows, idx := m.table.page(pageSize, visible, width)
+
+	return m.Render(MultiTableSelectQuestionTemplate, MultiTableSelectTemplateData{
+		MultiTableSelect: *m,
+		Header:           m.table.header(width),
+		PageEntries:      rows,
+		SelectedIndex:    idx,
+		Checked:          m.checked,
+		ShowHelp:         m.showingHelp,
+		Config:           config,
+	})
+}
+
+// defaultIndices returns the indices of the rows described by the default
+func (m *MultiTableSelect) defaultIndices(t *table) ([]int, error) {
+	var rows []interface{}
+	switch v := m.Default.(type) {
+	case nil:
+	case []string:
+		for _, value := range v {
+			rows = append(rows, value)
+		}
+	case []int:
+		for _, index := range v {
+			rows = append(rows, index)
+		}
+	default:
+		return nil, errors.New("default value of a multi table select must be a slice of ints or strings")
+	}
+
+	indices := []int{}
+	for _, row := range rows {
+		index, err := t.indexOf(row, m.ValueColumn)
+		if err != nil {
+			return nil, err
+		}
+		indices = append(indices, index)
+	}
+	return indices, nil
+}
+
+// start builds the table and selects the default rows
+func (m *MultiTableSelect) start() error {
+	if len(m.Rows) == 0 {
+		return errors.New("please provide rows to select from")
+	}
+
+	m.table = newTable(m.Headers, m.Rows)
+	indices, err := m.defaultIndices(m.table)
+	if err != nil {
+		return fmt.Errorf("default value %w", err)
+	}
+
+	m.checked = map[int]bool{}
+	for i, index := range indices {
+		m.checked[index] = true
+		// start on the first default row
+		if i == 0 {
+			m.table.focus = index
+		}
+	}
+	return nil
+}
+
+// checkedAnswers returns the answers for the selected rows in the order of Rows
+func (m *MultiTableSelect) checkedAnswers() []core.OptionAnswer {
+	answers := []core.OptionAnswer{}
+	for i := range m.Rows {
+		if m.checked[i] {
+			answers = append(answers, m.table.answer(i, m.ValueColumn))
+		}
+	}
+	return answers
+}
+
+func (m *MultiTableSelect) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := m.start(); err != nil {
+		return nil, err
+	}
+
+	if !m.interactive() {
+		return m.promptPlain(config)
+	}
+
+	cursor := m.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := m.render(config); err != nil {
+		return nil, err
+	}
+
+	rr := m.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			m.filter = ""
+			m.FilterMessage = ""
+			return nil, ErrGoBack
+		}
+		if r == terminal.KeyEnter || r == '\n' || r == terminal.KeyEndTransmission {
+			break
+		}
+		m.OnChange(r, config)
+	}
+	m.filter = ""
+	m.FilterMessage = ""
+
+	return m.checkedAnswers(), nil
+}
+
+// promptPlain lists the numbered rows and reads a line with the numbers or values of the rows to
+// check, separated by commas.
+func (m *MultiTableSelect) promptPlain(config *PromptConfig) (interface{}, error) {
+	defaults := []string{}
+	for _, answer := range m.checkedAnswers() {
+		defaults = append(defaults, answer.Value)
+	}
+
+	return m.askPlain(config, PlainTemplateData{
+		Message:      m.Message,
+		Help:         m.Help,
+		Options:      m.table.plainOptions(),
+		Instructions: "Enter numbers or values separated by commas",
+		Default:      strings.Join(defaults, ", "),
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return m.checkedAnswers(), nil
+		}
+
+		checked := map[int]bool{}
+		for _, part := range strings.Split(line, ",") {
+			index, err := m.table.parsePlain(part, m.ValueColumn)
+			if err != nil {
+				return nil, err
+			}
+			checked[index] = true
+		}
+
+		m.checked = checked
+		return m.checkedAnswers(), nil
+	})
+}
+
+// parseAnswer checks the rows given by an AnswerSource, either as a list or separated by commas.
+// The rows are given like the one of a TableSelect.
+func (m *MultiTableSelect) parseAnswer(value interface{}) (interface{}, error) {
+	var values []interface{}
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			for _, str := range strings.Split(v, ",") {
+				values = append(values, str)
+			}
+		}
+	case []string:
+		for _, str := range v {
+			values = append(values, str)
+		}
+	case []interface{}:
+		values = v
+	default:
+		return nil, fmt.Errorf("cannot use %T as the answer to a multi table select", value)
+	}
+
+	if err := m.start(); err != nil {
+		return nil, err
+	}
+	m.checked = map[int]bool{}
+	for _, item := range values {
+		index, err := m.table.sourceRow(item, m.ValueColumn)
+		if err != nil {
+			return nil, err
+		}
+		m.checked[index] = true
+	}
+	return m.checkedAnswers(), nil
+}
+
+// defaultAnswer returns the rows that are checked when the prompt starts.
+func (m *MultiTableSelect) defaultAnswer() (interface{}, error) {
+	if err := m.start(); err != nil {
+		return nil, err
+	}
+	return m.checkedAnswers(), nil
+}
+
+// SetDefault uses the given rows as the default selection. It accepts a slice of OptionAnswer,
+// a slice of row indices or a slice of the cells of the rows in ValueColumn.
+func (m *MultiTableSelect) SetDefault(value interface{}) error {
+	if answers, ok := value.([]core.OptionAnswer); ok {
+		indices := []int{}
+		for _, answer := range answers {
+			indices = append(indices, answer.Index)
+		}
+		value = indices
+	}
+
+	previous := m.Default
+	m.Default = value
+	if _, err := m.defaultIndices(newTable(m.Headers, m.Rows)); err != nil {
+		m.Default = previous
+		return err
+	}
+	return nil
+}
+
+func (m *MultiTableSelect) Cleanup(config *PromptConfig, val interface{}) error {
+	if !m.interactive() {
+		return nil
+	}
+
+	answers := []string{}
+	for _, answer := range val.([]core.OptionAnswer) {
+		answers = append(answers, answer.Value)
+	}
+
+	return m.Render(
+		MultiTableSelectQuestionTemplate,
+		MultiTableSelectTemplateData{
+			MultiTableSelect: *m,
+			Answer:           strings.Join(answers, ", "),
+			ShowAnswer:       true,
+			Config:           config,
+		},
+	)
+}
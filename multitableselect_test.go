@@ -0,0 +1,104 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestMultiTableSelectPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"select rows",
+			&MultiTableSelect{
+				Message: "Which instances?",
+				Headers: instanceHeaders,
+				Rows:    instanceRows(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which instances?")
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "web-1", Index: 0}, {Value: "worker-1", Index: 2}},
+		},
+		{
+			"select sorted rows",
+			&MultiTableSelect{
+				Message: "Which instances?",
+				Headers: instanceHeaders,
+				Rows:    instanceRows(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which instances?")
+				// sort by the number of cpus, largest first
+				c.Send(string(terminal.KeyArrowLeft))
+				c.ExpectString("CPUS ▼")
+				// the focused row is now the last one
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "db-1", Index: 1}},
+		},
+		{
+			"filter and default",
+			&MultiTableSelect{
+				Message:     "Which regions?",
+				Headers:     instanceHeaders,
+				Rows:        instanceRows(),
+				ValueColumn: 1,
+				Default:     []string{"eu-west-1"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which regions?")
+				c.Send("worker")
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{{Value: "eu-west-1", Index: 1}, {Value: "us-west-2", Index: 2}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestMultiTableSelectSetDefault(t *testing.T) {
+	prompt := &MultiTableSelect{Headers: instanceHeaders, Rows: instanceRows()}
+
+	assert.NoError(t, prompt.SetDefault([]string{"db-1"}))
+	assert.Equal(t, []string{"db-1"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault([]core.OptionAnswer{{Value: "worker-1", Index: 2}}))
+	assert.Equal(t, []int{2}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault([]string{"missing"}))
+	assert.Error(t, prompt.SetDefault([]int{5}))
+	assert.Error(t, prompt.SetDefault("db-1"))
+	assert.Equal(t, []int{2}, prompt.Default)
+}
+
+func TestMultiTableSelect_SourceNumbers(t *testing.T) {
+	prompt := &MultiTableSelect{Rows: instanceRows()}
+
+	ans, err := prompt.parseAnswer([]interface{}{float64(0), "worker-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []core.OptionAnswer{{Value: "web-1", Index: 0}, {Value: "worker-1", Index: 2}}, ans)
+
+	_, err = prompt.parseAnswer([]interface{}{0.5})
+	assert.Error(t, err)
+}
@@ -0,0 +1,367 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+MultiTreeSelect is a prompt that presents a tree of options to the user for them to select
+using the arrow keys, space and enter. The right arrow expands a node and the left arrow
+collapses it, and typing filters the nodes while keeping the ancestors of the matches visible.
+Response type is a slice of core.TreeAnswer with the value and the path of every node. Default
+holds the paths of the nodes that are selected when the prompt starts.
+
+	keys := []survey.TreeAnswer{}
+	prompt := &survey.MultiTreeSelect{
+		Message: "Which settings should be reset?",
+		Options: []survey.TreeNode{
+			{Value: "server", Children: []survey.TreeNode{{Value: "host"}, {Value: "port"}}},
+			{Value: "log", Children: []survey.TreeNode{{Value: "level"}}},
+		},
+	}
+	survey.AskOne(prompt, &keys)
+*/
+type MultiTreeSelect struct {
+	Renderer
+	Message       string
+	Options       []TreeNode
+	Default       [][]string
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	Filter        func(filter string, value string, index int) bool
+	tree          *tree
+	filter        string
+	checked       map[int]bool
+	showingHelp   bool
+}
+
+// MultiTreeSelectTemplateData is the data available to the templates when processing
+type MultiTreeSelectTemplateData struct {
+	MultiTreeSelect
+	PageEntries   []TreeRow
+	SelectedIndex int
+	Checked       map[int]bool
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+	Config        *PromptConfig
+}
+
+var MultiTreeSelectQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, space to select, right to expand, left to collapse, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- if eq $.SelectedIndex $ix }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }}{{color "reset"}}{{else}} {{end}}
+    {{- if index $.Checked $row.Index }}{{color $.Config.Icons.MarkedOption.Format }} {{ $.Config.Icons.MarkedOption.Text }} {{else}}{{color $.Config.Icons.UnmarkedOption.Format }} {{ $.Config.Icons.UnmarkedOption.Text }} {{end}}
+    {{- color "reset"}}
+    {{- " "}}{{- $row.Guide}}{{if $row.Expanded}}▾ {{else if $row.HasChildren}}▸ {{end}}{{ $row.Value }}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// match returns the filter used to find the nodes the user is looking for
+func (m *MultiTreeSelect) match(config *PromptConfig) func(filter string, value string, index int) bool {
+	if m.Filter != nil {
+		return m.Filter
+	}
+	return config.Filter
+}
+
+// OnChange is called on every keypress.
+func (m *MultiTreeSelect) OnChange(key rune, config *PromptConfig) {
+	visible := m.tree.visible(m.filter, m.match(config))
+	filtering := m.filter != ""
+
+	if key == terminal.KeyArrowUp || (m.VimMode && key == 'k') {
+		m.tree.move(visible, -1)
+	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (m.VimMode && key == 'j') {
+		m.tree.move(visible, 1)
+	} else if key == terminal.KeyArrowRight || (m.VimMode && key == 'l') {
+		m.tree.expand(visible, filtering)
+	} else if key == terminal.KeyArrowLeft || (m.VimMode && key == 'h') {
+		m.tree.collapse(visible, filtering)
+	} else if key == terminal.KeySpace {
+		if len(visible) > 0 {
+			m.tree.position(visible)
+			m.checked[m.tree.focus] = !m.checked[m.tree.focus]
+			if !config.KeepFilter {
+				m.filter = ""
+			}
+		}
+	} else if string(key) == config.HelpInput && m.Help != "" {
+		m.showingHelp = true
+	} else if key == terminal.KeyEscape {
+		m.VimMode = !m.VimMode
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		m.filter = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if m.filter != "" {
+			runeFilter := []rune(m.filter)
+			m.filter = string(runeFilter[0 : len(runeFilter)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		m.filter += string(key)
+		m.VimMode = false
+	}
+
+	m.FilterMessage = ""
+	if m.filter != "" {
+		m.FilterMessage = " " + m.filter
+	}
+
+	_ = m.render(config)
+}
+
+// render shows the page of the tree with the focused node
+func (m *MultiTreeSelect) render(config *PromptConfig) error {
+	pageSize := m.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	visible := m.tree.visible(m.filter, m.match(config))
+	rows, idx := m.tree.page(pageSize, m.tree.rows(visible), visible)
+
+	return m.Render(MultiTreeSelectQuestionTemplate, MultiTreeSelectTemplateData{
+		MultiTreeSelect: *m,
+		PageEntries:     rows,
+		SelectedIndex:   idx,
+		Checked:         m.checked,
+		ShowHelp:        m.showingHelp,
+		Config:          config,
+	})
+}
+
+// start builds the tree and selects the default nodes
+func (m *MultiTreeSelect) start() error {
+	if len(m.Options) == 0 {
+		return errors.New("please provide options to select from")
+	}
+
+	m.tree = newTree(m.Options)
+	m.checked = map[int]bool{}
+	for i, path := range m.Default {
+		index, err := m.tree.find(path)
+		if err != nil {
+			return fmt.Errorf("default value %w", err)
+		}
+		m.checked[index] = true
+		m.tree.reveal(index)
+		// start on the first default node
+		if i == 0 {
+			m.tree.focus = index
+		}
+	}
+	return nil
+}
+
+// checkedAnswers returns the answers for the selected nodes in the order of the tree
+func (m *MultiTreeSelect) checkedAnswers() []core.TreeAnswer {
+	answers := []core.TreeAnswer{}
+	for i := range m.tree.items {
+		if m.checked[i] {
+			answers = append(answers, m.tree.answer(i))
+		}
+	}
+	return answers
+}
+
+func (m *MultiTreeSelect) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := m.start(); err != nil {
+		return nil, err
+	}
+
+	if !m.interactive() {
+		return m.promptPlain(config)
+	}
+
+	cursor := m.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := m.render(config); err != nil {
+		return nil, err
+	}
+
+	rr := m.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			m.filter = ""
+			m.FilterMessage = ""
+			return nil, ErrGoBack
+		}
+		if r == terminal.KeyEnter || r == '\n' || r == terminal.KeyEndTransmission {
+			break
+		}
+		m.OnChange(r, config)
+	}
+	m.filter = ""
+	m.FilterMessage = ""
+
+	return m.checkedAnswers(), nil
+}
+
+// promptPlain lists the numbered nodes and reads a line with the numbers, paths or values of the
+// nodes to check, separated by commas.
+func (m *MultiTreeSelect) promptPlain(config *PromptConfig) (interface{}, error) {
+	defaults := []string{}
+	for _, answer := range m.checkedAnswers() {
+		defaults = append(defaults, answer.String())
+	}
+
+	return m.askPlain(config, PlainTemplateData{
+		Message:      m.Message,
+		Help:         m.Help,
+		Options:      m.tree.plainOptions(),
+		Instructions: "Enter numbers or paths separated by commas",
+		Default:      strings.Join(defaults, ", "),
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return m.checkedAnswers(), nil
+		}
+
+		checked := map[int]bool{}
+		for _, part := range strings.Split(line, ",") {
+			index, err := m.tree.parsePlain(part)
+			if err != nil {
+				return nil, err
+			}
+			checked[index] = true
+		}
+
+		m.checked = checked
+		return m.checkedAnswers(), nil
+	})
+}
+
+// parseAnswer checks the nodes given by an AnswerSource, either as a list or separated by commas.
+func (m *MultiTreeSelect) parseAnswer(value interface{}) (interface{}, error) {
+	var values []interface{}
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			for _, str := range strings.Split(v, ",") {
+				values = append(values, str)
+			}
+		}
+	case []string:
+		for _, str := range v {
+			values = append(values, str)
+		}
+	case []interface{}:
+		values = v
+	default:
+		return nil, fmt.Errorf("cannot use %T as the answer to a multi tree select", value)
+	}
+
+	t := newTree(m.Options)
+	checked := map[int]bool{}
+	for _, node := range values {
+		index, err := t.indexOf(node)
+		if err != nil {
+			return nil, err
+		}
+		checked[index] = true
+	}
+
+	// the answers are in the order of the tree like the ones of the prompt
+	answers := []core.TreeAnswer{}
+	for i := range t.items {
+		if checked[i] {
+			answers = append(answers, t.answer(i))
+		}
+	}
+	return answers, nil
+}
+
+// defaultAnswer returns the nodes that are checked when the prompt starts.
+func (m *MultiTreeSelect) defaultAnswer() (interface{}, error) {
+	if err := m.start(); err != nil {
+		return nil, err
+	}
+	return m.checkedAnswers(), nil
+}
+
+// SetDefault uses the given nodes as the default selection. It accepts a slice of TreeAnswer, a
+// slice of paths, or a slice of strings with either the paths separated by slashes or the values
+// of the nodes.
+func (m *MultiTreeSelect) SetDefault(value interface{}) error {
+	t := newTree(m.Options)
+
+	var nodes []interface{}
+	switch v := value.(type) {
+	case []core.TreeAnswer:
+		for _, answer := range v {
+			nodes = append(nodes, answer)
+		}
+	case [][]string:
+		for _, path := range v {
+			nodes = append(nodes, path)
+		}
+	case []string:
+		for _, str := range v {
+			nodes = append(nodes, str)
+		}
+	case []interface{}:
+		nodes = v
+	default:
+		return fmt.Errorf("cannot use %T as the default of a multi tree select", value)
+	}
+
+	defaults := [][]string{}
+	for _, node := range nodes {
+		index, err := t.indexOf(node)
+		if err != nil {
+			return err
+		}
+		defaults = append(defaults, t.answer(index).Path)
+	}
+	m.Default = defaults
+	return nil
+}
+
+func (m *MultiTreeSelect) Cleanup(config *PromptConfig, val interface{}) error {
+	if !m.interactive() {
+		return nil
+	}
+
+	answers := []string{}
+	for _, answer := range val.([]core.TreeAnswer) {
+		answers = append(answers, answer.String())
+	}
+
+	return m.Render(
+		MultiTreeSelectQuestionTemplate,
+		MultiTreeSelectTemplateData{
+			MultiTreeSelect: *m,
+			Answer:          strings.Join(answers, ", "),
+			ShowAnswer:      true,
+			Config:          config,
+		},
+	)
+}
@@ -0,0 +1,107 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestMultiTreeSelectPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"basic interaction",
+			&MultiTreeSelect{
+				Message: "Choose resources:",
+				Options: resourceTree(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose resources:  [Use arrows to move, space to select, right to expand, left to collapse, type to filter]")
+				// pick kube-system and default/services
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine(" ")
+				c.ExpectEOF()
+			},
+			[]core.TreeAnswer{
+				{Value: "services", Path: []string{"default", "services"}, Index: 4},
+				{Value: "kube-system", Path: []string{"kube-system"}, Index: 6},
+			},
+		},
+		{
+			"default value",
+			&MultiTreeSelect{
+				Message: "Choose resources:",
+				Options: resourceTree(),
+				Default: [][]string{{"default", "deployments", "web"}, {"kube-system", "pods", "coredns"}},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose resources:")
+				// unselect the first default
+				c.SendLine(" ")
+				c.ExpectEOF()
+			},
+			[]core.TreeAnswer{
+				{Value: "coredns", Path: []string{"kube-system", "pods", "coredns"}, Index: 8},
+			},
+		},
+		{
+			"filter and select",
+			&MultiTreeSelect{
+				Message: "Choose resources:",
+				Options: resourceTree(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose resources:")
+				// the first match is the last node shown
+				c.Send("work")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(" ")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.TreeAnswer{
+				{Value: "worker", Path: []string{"default", "deployments", "worker"}, Index: 3},
+			},
+		},
+		{
+			"nothing selected",
+			&MultiTreeSelect{
+				Message: "Choose resources:",
+				Options: resourceTree(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose resources:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.TreeAnswer{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestMultiTreeSelectSetDefault(t *testing.T) {
+	prompt := &MultiTreeSelect{Options: resourceTree()}
+
+	assert.NoError(t, prompt.SetDefault([]core.TreeAnswer{{Path: []string{"default", "services"}}}))
+	assert.Equal(t, [][]string{{"default", "services"}}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault([]string{"coredns", "default/deployments"}))
+	assert.Equal(t, [][]string{{"kube-system", "pods", "coredns"}, {"default", "deployments"}}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault([]string{"web"}))
+	assert.Error(t, prompt.SetDefault("coredns"))
+}
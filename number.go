@@ -0,0 +1,357 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+Number is a prompt for numbers. Keys that can't be part of a number are ignored, and the up and
+down arrows add or subtract Step from the current value. The answer can't be less than Min or more
+than Max when they are set. Response type is an int, or a float64 if Float is set.
+
+	replicas := 0
+	min, max := 1.0, 10.0
+	prompt := &survey.Number{
+		Message: "How many replicas?",
+		Default: 3,
+		Min:     &min,
+		Max:     &max,
+	}
+	survey.AskOne(prompt, &replicas)
+*/
+type Number struct {
+	Renderer
+	Message     string
+	Default     float64
+	Help        string
+	Min         *float64
+	Max         *float64
+	Step        float64
+	Float       bool
+	showingHelp bool
+}
+
+// data available to the templates when processing
+type NumberTemplateData struct {
+	Number
+	ShowAnswer bool
+	ShowHelp   bool
+	Answer     string
+	Range      string
+	Value      string
+	Config     *PromptConfig
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var NumberQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- color "cyan"}}[
+    {{- if and .Help (not .ShowHelp)}}{{ print .Config.HelpInput }} for help, {{end -}}
+    {{- if .Range}}{{ .Range }}, {{end -}}
+    arrows to change]{{color "reset"}} {{color "white"}}({{.Value}}) {{color "reset"}}
+{{- end}}`
+
+// rangeText describes the bounds of the answer, if there are any
+func (n *Number) rangeText() string {
+	switch {
+	case n.Min != nil && n.Max != nil:
+		return fmt.Sprintf("%s to %s", n.format(*n.Min), n.format(*n.Max))
+	case n.Min != nil:
+		return fmt.Sprintf("at least %s", n.format(*n.Min))
+	case n.Max != nil:
+		return fmt.Sprintf("at most %s", n.format(*n.Max))
+	}
+	return ""
+}
+
+// step returns the amount the arrow keys change the value by
+func (n *Number) step() float64 {
+	if n.Step > 0 {
+		return n.Step
+	}
+	return 1
+}
+
+// clamp moves a value inside of the bounds of the prompt
+func (n *Number) clamp(value float64) float64 {
+	if n.Min != nil {
+		value = math.Max(*n.Min, value)
+	}
+	if n.Max != nil {
+		value = math.Min(*n.Max, value)
+	}
+	if !n.Float {
+		value = math.Round(value)
+	}
+	return value
+}
+
+// defaultValue returns the answer used when the user doesn't type anything
+func (n *Number) defaultValue() float64 {
+	return n.clamp(n.Default)
+}
+
+// format returns the number the way the user would type it
+func (n *Number) format(value float64) string {
+	if !n.Float {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// typed returns the value with the type of the answer
+func (n *Number) typed(value float64) interface{} {
+	if !n.Float {
+		return int(value)
+	}
+	return value
+}
+
+// parse converts what the user typed into the answer
+func (n *Number) parse(text string) (interface{}, error) {
+	text = strings.TrimSpace(text)
+
+	var value float64
+	if n.Float {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%q is not a number", text)
+		}
+		value = f
+	} else {
+		i, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a whole number", text)
+		}
+		value = float64(i)
+	}
+
+	switch {
+	case n.Min != nil && n.Max != nil && (value < *n.Min || value > *n.Max):
+		return nil, fmt.Errorf("%s is not between %s and %s", text, n.format(*n.Min), n.format(*n.Max))
+	case n.Min != nil && value < *n.Min:
+		return nil, fmt.Errorf("%s is less than %s", text, n.format(*n.Min))
+	case n.Max != nil && value > *n.Max:
+		return nil, fmt.Errorf("%s is more than %s", text, n.format(*n.Max))
+	}
+
+	return n.typed(value), nil
+}
+
+// accepts returns whether the key can be typed
+func (n *Number) accepts(key rune, config *PromptConfig) bool {
+	switch {
+	case key >= '0' && key <= '9':
+		return true
+	case key == '-':
+		return n.Min == nil || *n.Min < 0
+	case key == '.':
+		return n.Float
+	case n.Help != "" && strings.ContainsRune(config.HelpInput, key):
+		return true
+	}
+	return false
+}
+
+// increment adds the given number of steps to the value the user typed
+func (n *Number) increment(line []rune, steps float64) string {
+	// start from the default until the user typed something
+	value := n.defaultValue()
+	if current, err := strconv.ParseFloat(string(line), 64); err == nil {
+		value = current
+	}
+	value += steps * n.step()
+
+	// avoid showing rounding errors by keeping to the precision of the step or the typed value
+	precision := math.Max(decimals(n.format(n.step())), decimals(string(line)))
+	scale := math.Pow(10, precision)
+
+	return n.format(n.clamp(math.Round(value*scale) / scale))
+}
+
+// decimals returns the number of digits after the decimal point
+func decimals(text string) float64 {
+	if i := strings.Index(text, "."); i >= 0 {
+		return float64(len(text) - i - 1)
+	}
+	return 0
+}
+
+func (n *Number) templateData(config *PromptConfig) NumberTemplateData {
+	return NumberTemplateData{
+		Number:   *n,
+		ShowHelp: n.showingHelp,
+		Range:    n.rangeText(),
+		Value:    n.format(n.defaultValue()),
+		Config:   config,
+	}
+}
+
+func (n *Number) onRune(config *PromptConfig) terminal.OnRuneFn {
+	return terminal.OnRuneFn(func(key rune, line []rune) ([]rune, bool, error) {
+		if config.BackKey != 0 && key == config.BackKey {
+			return line, true, ErrGoBack
+		}
+
+		var newLine []rune
+		switch {
+		case key == terminal.KeyArrowUp:
+			newLine = []rune(n.increment(line, 1))
+		case key == terminal.KeyArrowDown:
+			newLine = []rune(n.increment(line, -1))
+		case unicode.IsControl(key) || key == terminal.IgnoreKey || n.accepts(key, config):
+			// let the line editor handle the key
+			return line, false, nil
+		default:
+			// ignore the key by drawing the line again without it
+			newLine = line
+		}
+
+		err := n.Render(NumberQuestionTemplate, n.templateData(config))
+		if err == nil {
+			err = errReadLineAgain
+		}
+		return newLine, true, err
+	})
+}
+
+func (n *Number) Prompt(config *PromptConfig) (interface{}, error) {
+	if n.Min != nil && n.Max != nil && *n.Max < *n.Min {
+		return nil, errors.New("the maximum is less than the minimum")
+	}
+
+	if !n.interactive() {
+		return n.promptPlain(config)
+	}
+
+	// render the template
+	err := n.Render(NumberQuestionTemplate, n.templateData(config))
+	if err != nil {
+		return nil, err
+	}
+
+	// start reading runes from the standard in
+	rr := n.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+	cursor := n.NewCursor()
+
+	var line []rune
+	for {
+		line, err = rr.ReadLineWithDefault(0, line, n.onRune(config))
+		if err == errReadLineAgain {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// readline print an empty line, go up before we render the follow up
+		cursor.PreviousLine(1)
+		text := string(line)
+
+		// if we ran into the help string
+		if text == config.HelpInput && n.Help != "" {
+			n.showingHelp = true
+		} else if text == "" {
+			// use the default value
+			return n.typed(n.defaultValue()), nil
+		} else {
+			answer, parseErr := n.parse(text)
+			if parseErr == nil {
+				return answer, nil
+			}
+			if err := n.Error(config, parseErr); err != nil {
+				return nil, err
+			}
+		}
+
+		// ask again
+		line = nil
+		if err := n.Render(NumberQuestionTemplate, n.templateData(config)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// promptPlain reads the number the user types, showing the range it has to be in.
+func (n *Number) promptPlain(config *PromptConfig) (interface{}, error) {
+	instructions := ""
+	if r := n.rangeText(); r != "" {
+		instructions = fmt.Sprintf("[%s]", r)
+	}
+
+	return n.askPlain(config, PlainTemplateData{
+		Message:      n.Message,
+		Help:         n.Help,
+		Instructions: instructions,
+		Default:      n.format(n.defaultValue()),
+	}, func(line string) (interface{}, error) {
+		// if the line is empty
+		if line == "" {
+			// use the default value
+			return n.defaultAnswer()
+		}
+		return n.parseAnswer(line)
+	})
+}
+
+// parseAnswer accepts a number from an AnswerSource, or a string with the number the user would
+// type, and checks it against the bounds.
+func (n *Number) parseAnswer(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return n.parse(v)
+	case float64:
+		return n.parse(strconv.FormatFloat(v, 'f', -1, 64))
+	case int:
+		return n.parse(strconv.Itoa(v))
+	}
+	return nil, fmt.Errorf("cannot use %T as the answer to a number", value)
+}
+
+// defaultAnswer returns the default inside of the bounds.
+func (n *Number) defaultAnswer() (interface{}, error) {
+	return n.typed(n.defaultValue()), nil
+}
+
+// SetDefault uses the given number as the default answer.
+func (n *Number) SetDefault(value interface{}) error {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n.Default = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n.Default = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		n.Default = v.Float()
+	default:
+		return fmt.Errorf("cannot use %T as the default of a number", value)
+	}
+	return nil
+}
+
+func (n *Number) Cleanup(config *PromptConfig, val interface{}) error {
+	if !n.interactive() {
+		return nil
+	}
+	data := n.templateData(config)
+	data.ShowAnswer = true
+	data.Answer = fmt.Sprint(val)
+	return n.Render(NumberQuestionTemplate, data)
+}
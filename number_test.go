@@ -0,0 +1,239 @@
+package survey
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+)
+
+// bound returns a pointer to a bound of a Number
+func bound(value float64) *float64 {
+	return &value
+}
+
+func TestNumberRender(t *testing.T) {
+	tests := []struct {
+		title    string
+		prompt   Number
+		data     NumberTemplateData
+		expected string
+	}{
+		{
+			"Test Number question output",
+			Number{Message: "How many replicas?", Default: 3},
+			NumberTemplateData{},
+			fmt.Sprintf("%s How many replicas? [arrows to change] (3) ", defaultIcons().Question.Text),
+		},
+		{
+			"Test Number question output with bounds",
+			Number{Message: "How many replicas?", Min: bound(1), Max: bound(10)},
+			NumberTemplateData{},
+			fmt.Sprintf("%s How many replicas? [1 to 10, arrows to change] (1) ", defaultIcons().Question.Text),
+		},
+		{
+			"Test Number question output with a minimum",
+			Number{Message: "How many replicas?", Min: bound(1)},
+			NumberTemplateData{},
+			fmt.Sprintf("%s How many replicas? [at least 1, arrows to change] (1) ", defaultIcons().Question.Text),
+		},
+		{
+			"Test Number question output with a maximum",
+			Number{Message: "How many replicas?", Max: bound(10)},
+			NumberTemplateData{},
+			fmt.Sprintf("%s How many replicas? [at most 10, arrows to change] (0) ", defaultIcons().Question.Text),
+		},
+		{
+			"Test Number question output with help hidden",
+			Number{Message: "What percentage?", Default: 0.5, Float: true, Help: "This is helpful"},
+			NumberTemplateData{},
+			fmt.Sprintf("%s What percentage? [%s for help, arrows to change] (0.5) ", defaultIcons().Question.Text, defaultPromptConfig().HelpInput),
+		},
+		{
+			"Test Number question output with help shown",
+			Number{Message: "What percentage?", Help: "This is helpful"},
+			NumberTemplateData{ShowHelp: true},
+			fmt.Sprintf("%s This is helpful\n%s What percentage? [arrows to change] (0) ", defaultIcons().Help.Text, defaultIcons().Question.Text),
+		},
+		{
+			"Test Number answer output",
+			Number{Message: "How many replicas?"},
+			NumberTemplateData{ShowAnswer: true, Answer: "5"},
+			fmt.Sprintf("%s How many replicas? 5\n", defaultIcons().Question.Text),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+
+			data := test.prompt.templateData(defaultPromptConfig())
+			data.ShowHelp = test.data.ShowHelp
+			data.ShowAnswer = test.data.ShowAnswer
+			data.Answer = test.data.Answer
+
+			err = test.prompt.Render(NumberQuestionTemplate, data)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestNumberPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"Test Number prompt interaction",
+			&Number{Message: "How many replicas?"},
+			func(c expectConsole) {
+				c.ExpectString("How many replicas?")
+				c.SendLine("12")
+				c.ExpectEOF()
+			},
+			12,
+		},
+		{
+			"Test Number prompt interaction with default",
+			&Number{Message: "How many replicas?", Default: 3},
+			func(c expectConsole) {
+				c.ExpectString("How many replicas?")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			3,
+		},
+		{
+			"Test Number prompt ignores other keys",
+			&Number{Message: "How many replicas?"},
+			func(c expectConsole) {
+				c.ExpectString("How many replicas?")
+				c.Send("1")
+				c.Send("x")
+				c.SendLine("2")
+				c.ExpectEOF()
+			},
+			12,
+		},
+		{
+			"Test Number prompt arrows change the value",
+			&Number{Message: "How many replicas?", Default: 3, Min: bound(1), Max: bound(4)},
+			func(c expectConsole) {
+				c.ExpectString("How many replicas?")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			3,
+		},
+		{
+			"Test Number prompt arrows use the step",
+			&Number{Message: "What percentage?", Default: 0.1, Step: 0.1, Float: true},
+			func(c expectConsole) {
+				c.ExpectString("What percentage?")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			0.3,
+		},
+		{
+			"Test Number prompt rejects numbers out of bounds",
+			&Number{Message: "How many replicas?", Min: bound(1), Max: bound(10)},
+			func(c expectConsole) {
+				c.ExpectString("How many replicas?")
+				c.SendLine("11")
+				c.ExpectString("11 is not between 1 and 10")
+				c.SendLine("10")
+				c.ExpectEOF()
+			},
+			10,
+		},
+		{
+			"Test Number prompt interaction and prompt for help",
+			&Number{Message: "How many replicas?", Help: "Pods to run"},
+			func(c expectConsole) {
+				c.ExpectString("How many replicas?")
+				c.SendLine("?")
+				c.ExpectString("Pods to run")
+				c.SendLine("2")
+				c.ExpectEOF()
+			},
+			2,
+		},
+		{
+			"Test Number prompt float",
+			&Number{Message: "What percentage?", Float: true},
+			func(c expectConsole) {
+				c.ExpectString("What percentage?")
+				c.SendLine("-2.5")
+				c.ExpectEOF()
+			},
+			-2.5,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestNumberParse(t *testing.T) {
+	prompt := &Number{Min: bound(-5), Max: bound(5)}
+
+	ans, err := prompt.parse(" -3 ")
+	assert.NoError(t, err)
+	assert.Equal(t, -3, ans)
+
+	_, err = prompt.parse("1.5")
+	assert.EqualError(t, err, `"1.5" is not a whole number`)
+
+	_, err = prompt.parse("6")
+	assert.EqualError(t, err, "6 is not between -5 and 5")
+
+	// a single bound is enough
+	_, err = (&Number{Min: bound(1)}).parse("-5")
+	assert.EqualError(t, err, "-5 is less than 1")
+	_, err = (&Number{Max: bound(0)}).parse("3")
+	assert.EqualError(t, err, "3 is more than 0")
+	assert.False(t, (&Number{Min: bound(0)}).accepts('-', defaultPromptConfig()))
+
+	prompt.Float = true
+	ans, err = prompt.parse("1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, ans)
+
+	_, err = prompt.parse("NaN")
+	assert.EqualError(t, err, `"NaN" is not a number`)
+}
+
+func TestNumberSetDefault(t *testing.T) {
+	prompt := &Number{}
+
+	assert.NoError(t, prompt.SetDefault(uint16(8080)))
+	assert.Equal(t, 8080.0, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault(0.5))
+	assert.Equal(t, 0.5, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault("8080"))
+	assert.Equal(t, 0.5, prompt.Default)
+}
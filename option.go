@@ -0,0 +1,301 @@
+package survey
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+/*
+Option is an option of a Select or a MultiSelect that has more to it than its value. The options
+given as Choices are listed in order, and a header with the name of their Group is shown above the
+options of each group. Options without a group should come first and the options of a group
+should follow each other.
+
+	prompt := &survey.Select{
+		Message: "Choose an environment:",
+		Choices: []survey.Option{
+			{Value: "dev", Label: "Development", Group: "Internal"},
+			{Value: "prod", Label: "Production", Group: "Public", Disabled: true, DisabledReason: "no permission"},
+		},
+	}
+
+Data is written to the response in place of the value, which makes it possible to show a label
+while answering with an id, a custom type or a struct. The core.OptionAnswer of the option still
+has its Value and Index, along with the Data.
+
+	envID := 0
+	survey.AskOne(&survey.Select{
+		Message: "Choose an environment:",
+		Choices: []survey.Option{
+			{Value: "dev", Label: "Development", Data: 12},
+			{Value: "prod", Label: "Production", Data: 7},
+		},
+	}, &envID)
+
+When the prompt is asked with WithHotkeys, pressing the Key of an option picks it. The options
+that can be chosen and have no Key of their own are numbered from 1 to 9 instead.
+*/
+type Option struct {
+	// Value is what the option answers with when it has no Data, and what defaults and answers
+	// given without a terminal are matched against
+	Value string
+	// Label is shown instead of the value when it isn't empty
+	Label string
+	// Disabled options are shown but can't be chosen
+	Disabled       bool
+	DisabledReason string
+	Group          string
+	Data           interface{}
+	Key            rune
+}
+
+// groupIndex is the Index of the entries that show the header of a group
+const groupIndex = -2
+
+// optionValues returns the values of the choices, or the options when there aren't any
+func optionValues(choices []Option, options []string) []string {
+	if choices == nil {
+		return options
+	}
+	values := []string{}
+	for _, choice := range choices {
+		values = append(values, choice.Value)
+	}
+	return values
+}
+
+// choiceAt returns the choice the option with the given index comes from, if there is one
+func choiceAt(choices []Option, index int) Option {
+	if index < 0 || index >= len(choices) {
+		return Option{}
+	}
+	return choices[index]
+}
+
+// choiceLabel returns how an option is shown
+func choiceLabel(choices []Option, opt core.OptionAnswer) string {
+	if label := choiceAt(choices, opt.Index).Label; label != "" {
+		return label
+	}
+	return opt.Value
+}
+
+// canChoose returns whether the cursor can land on an entry, which it can't for the group headers
+// and the disabled options
+func canChoose(choices []Option, opt core.OptionAnswer) bool {
+	return opt.Index != groupIndex && !choiceAt(choices, opt.Index).Disabled
+}
+
+// choiceAnswer adds the data of the option to the answer
+func choiceAnswer(choices []Option, ans core.OptionAnswer) core.OptionAnswer {
+	ans.Data = choiceAt(choices, ans.Index).Data
+	return ans
+}
+
+// dataIndex returns the index of the option with the given data
+func dataIndex(choices []Option, data interface{}) (int, bool) {
+	for i, choice := range choices {
+		if choice.Data != nil && reflect.DeepEqual(choice.Data, data) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// dataDefault returns the default of a Select or a MultiSelect whose options have data for a
+// response that holds that data, finding the options by their data instead of taking the
+// response for their values or indices. It returns false when the options have no data.
+func dataDefault(p Prompt, value interface{}) (interface{}, bool) {
+	var choices []Option
+	switch prompt := p.(type) {
+	case *Select:
+		choices = prompt.Choices
+	case *MultiSelect:
+		choices = prompt.Choices
+	}
+	if !hasData(choices) {
+		return nil, false
+	}
+
+	// the response holds the answers themselves
+	switch value.(type) {
+	case core.OptionAnswer, []core.OptionAnswer:
+		return nil, false
+	}
+
+	if _, ok := p.(*Select); ok {
+		if index, ok := dataIndex(choices, value); ok {
+			return index, true
+		}
+		return nil, true
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, true
+	}
+	indices := []int{}
+	for i := 0; i < v.Len(); i++ {
+		if index, ok := dataIndex(choices, v.Index(i).Interface()); ok {
+			indices = append(indices, index)
+		}
+	}
+	return indices, true
+}
+
+// hasData returns whether any of the options has data
+func hasData(choices []Option) bool {
+	for _, choice := range choices {
+		if choice.Data != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkChoice makes sure the answer isn't one of the disabled options
+func checkChoice(choices []Option, ans core.OptionAnswer) error {
+	choice := choiceAt(choices, ans.Index)
+	if !choice.Disabled {
+		return nil
+	}
+	if choice.DisabledReason != "" {
+		return fmt.Errorf("%q can't be chosen: %s", ans.Value, choice.DisabledReason)
+	}
+	return fmt.Errorf("%q can't be chosen", ans.Value)
+}
+
+// withGroups adds the header of a group before the first of its options
+func withGroups(choices []Option, options []core.OptionAnswer) []core.OptionAnswer {
+	entries := []core.OptionAnswer{}
+	group := ""
+	for _, opt := range options {
+		if g := choiceAt(choices, opt.Index).Group; g != group {
+			group = g
+			if g != "" {
+				entries = append(entries, core.OptionAnswer{Value: g, Index: groupIndex})
+			}
+		}
+		entries = append(entries, opt)
+	}
+	return entries
+}
+
+// nextChoice returns the entry the cursor lands on when it is moved to the given one, going on in
+// the direction of step past the entries it can't land on
+func nextChoice(choices []Option, options []core.OptionAnswer, index, step int) int {
+	for i := 0; i < len(options); i++ {
+		at := ((index+i*step)%len(options) + len(options)) % len(options)
+		if canChoose(choices, options[at]) {
+			return at
+		}
+	}
+	return index
+}
+
+// moveBy returns the entry the cursor lands on when it moves by delta entries, stopping at the ends
+// of the list instead of going around and skipping the entries it can't land on
+func moveBy(choices []Option, options []core.OptionAnswer, index, delta int) int {
+	if len(options) == 0 {
+		return index
+	}
+
+	target := index + delta
+	if target < 0 {
+		target = 0
+	} else if target >= len(options) {
+		target = len(options) - 1
+	}
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+
+	// look the other way when there is nothing to land on before the end of the list
+	for _, dir := range []int{step, -step} {
+		for i := target; i >= 0 && i < len(options); i += dir {
+			if canChoose(choices, options[i]) {
+				return i
+			}
+		}
+	}
+	return index
+}
+
+// entryOf returns where the option with the given index is listed, or the first entry the cursor
+// can land on after it when it can't land on that option
+func entryOf(choices []Option, options []core.OptionAnswer, index int) int {
+	for i, opt := range options {
+		if opt.Index == index {
+			return nextChoice(choices, options, i, 1)
+		}
+	}
+	return nextChoice(choices, options, 0, 1)
+}
+
+// hotkeys returns the key that picks each option. The options that can be chosen and have no key
+// of their own are numbered from 1 to 9, skipping the numbers taken by the keys of the others.
+func hotkeys(choices []Option, options []string) map[int]rune {
+	keys := map[int]rune{}
+	taken := map[rune]bool{}
+	for i := range options {
+		if key := choiceAt(choices, i).Key; key != 0 {
+			keys[i] = key
+			taken[key] = true
+		}
+	}
+
+	next := '1'
+	for i := range options {
+		choice := choiceAt(choices, i)
+		if choice.Key != 0 || choice.Disabled {
+			continue
+		}
+		for next <= '9' && taken[next] {
+			next++
+		}
+		if next > '9' {
+			break
+		}
+		keys[i] = next
+		next++
+	}
+	return keys
+}
+
+// hotkeyEntry returns where the option picked by the key is listed, if it can be chosen
+func hotkeyEntry(choices []Option, options []core.OptionAnswer, keys map[int]rune, key rune) (int, bool) {
+	for i, opt := range options {
+		if opt.Index >= 0 && keys[opt.Index] == key && canChoose(choices, opt) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// plainChoices numbers the options for the plain template, naming the group of the first option
+// of each group and why the disabled options can't be chosen
+func plainChoices(choices []Option, options []string) []PlainOption {
+	plain := plainOptions(options)
+	group := ""
+	for i := range plain {
+		choice := choiceAt(choices, i)
+		if choice.Group != group {
+			group = choice.Group
+			plain[i].Group = group
+		}
+		if choice.Label != "" {
+			plain[i].Value = choice.Label
+		}
+		if choice.Disabled {
+			reason := choice.DisabledReason
+			if reason == "" {
+				reason = "disabled"
+			}
+			plain[i].Value += " (" + reason + ")"
+		}
+	}
+	return plain
+}
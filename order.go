@@ -0,0 +1,330 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+Order is a prompt that presents a list of options for the user to put in order. Space grabs the
+focused option so that the arrow keys move it up and down, and space again drops it. Response
+type is a slice of core.OptionAnswer in the new order, each with the index of the option in
+Options. Default lists the options in the order they start in.
+
+	priorities := []string{}
+	prompt := &survey.Order{
+		Message: "Order the features by priority:",
+		Options: []string{"search", "export", "dark mode"},
+	}
+	survey.AskOne(prompt, &priorities)
+*/
+type Order struct {
+	Renderer
+	Message       string
+	Options       []string
+	Default       []string
+	Help          string
+	PageSize      int
+	VimMode       bool
+	order         []int
+	selectedIndex int
+	grabbed       bool
+	showingHelp   bool
+}
+
+// OrderTemplateData is the data available to the templates when processing
+type OrderTemplateData struct {
+	Order
+	PageEntries   []core.OptionAnswer
+	SelectedIndex int
+	Grabbed       bool
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+	Config        *PromptConfig
+}
+
+var OrderQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, space to grab and drop{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- range $ix, $option := .PageEntries}}
+    {{- if ne $.SelectedIndex $ix }}{{color "default"}}  {{else if $.Grabbed }}{{color $.Config.Icons.GrabbedOption.Format }}{{ $.Config.Icons.GrabbedOption.Text }} {{else}}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }} {{end}}
+    {{- $option.Value}}
+    {{- color "reset"}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// OnChange is called on every keypress.
+func (o *Order) OnChange(key rune, config *PromptConfig) {
+	if key == terminal.KeyArrowUp || (o.VimMode && key == 'k') {
+		o.move(-1)
+	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (o.VimMode && key == 'j') {
+		o.move(1)
+	} else if key == terminal.KeySpace {
+		// grab the focused option or drop the one that was grabbed
+		o.grabbed = !o.grabbed
+	} else if string(key) == config.HelpInput && o.Help != "" {
+		o.showingHelp = true
+	} else if key == terminal.KeyEscape {
+		o.VimMode = !o.VimMode
+	}
+
+	_ = o.render(config)
+}
+
+// move moves the focus by the given number of rows, taking the grabbed option along with it
+func (o *Order) move(delta int) {
+	target := o.selectedIndex + delta
+
+	if !o.grabbed {
+		// cycle through the options like a select
+		o.selectedIndex = (target + len(o.order)) % len(o.order)
+		return
+	}
+
+	// a grabbed option stops at the ends of the list
+	if target < 0 || target >= len(o.order) {
+		return
+	}
+	o.order[o.selectedIndex], o.order[target] = o.order[target], o.order[o.selectedIndex]
+	o.selectedIndex = target
+}
+
+// answers returns the options in their current order
+func (o *Order) answers() []core.OptionAnswer {
+	answers := []core.OptionAnswer{}
+	for _, index := range o.order {
+		answers = append(answers, core.OptionAnswer{Value: o.Options[index], Index: index})
+	}
+	return answers
+}
+
+// render shows the page of the options with the focused one
+func (o *Order) render(config *PromptConfig) error {
+	pageSize := o.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	opts, idx := paginate(pageSize, o.answers(), o.selectedIndex)
+
+	return o.Render(OrderQuestionTemplate, OrderTemplateData{
+		Order:         *o,
+		PageEntries:   opts,
+		SelectedIndex: idx,
+		Grabbed:       o.grabbed,
+		ShowHelp:      o.showingHelp,
+		Config:        config,
+	})
+}
+
+// arrange returns the order of the options when the given ones come first, in the given order,
+// followed by the rest in the order they had before.
+func arrange(options []string, before []int, first []string) ([]int, error) {
+	placed := map[int]bool{}
+	order := []int{}
+	for _, value := range first {
+		opt, err := findOption(options, strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		if placed[opt.Index] {
+			return nil, fmt.Errorf("%q is in the list more than once", opt.Value)
+		}
+		placed[opt.Index] = true
+		order = append(order, opt.Index)
+	}
+
+	for _, index := range before {
+		if !placed[index] {
+			order = append(order, index)
+		}
+	}
+	return order, nil
+}
+
+// start puts the options in their default order
+func (o *Order) start() error {
+	if len(o.Options) == 0 {
+		return errors.New("please provide options to order")
+	}
+
+	original := []int{}
+	for i := range o.Options {
+		original = append(original, i)
+	}
+
+	order, err := arrange(o.Options, original, o.Default)
+	if err != nil {
+		return fmt.Errorf("default value %w", err)
+	}
+
+	o.order = order
+	o.selectedIndex = 0
+	o.grabbed = false
+	return nil
+}
+
+func (o *Order) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := o.start(); err != nil {
+		return nil, err
+	}
+
+	if !o.interactive() {
+		return o.promptPlain(config)
+	}
+
+	cursor := o.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := o.render(config); err != nil {
+		return nil, err
+	}
+
+	rr := o.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			return nil, ErrGoBack
+		}
+		if r == terminal.KeyEnter || r == '\n' || r == terminal.KeyEndTransmission {
+			break
+		}
+		o.OnChange(r, config)
+	}
+
+	return o.answers(), nil
+}
+
+// promptPlain lists the numbered options and reads a line with the numbers or values of the
+// options in their new order. Options that are left out keep their order after the ones that
+// were given.
+func (o *Order) promptPlain(config *PromptConfig) (interface{}, error) {
+	current := []string{}
+	for _, index := range o.order {
+		current = append(current, o.Options[index])
+	}
+
+	return o.askPlain(config, PlainTemplateData{
+		Message:      o.Message,
+		Help:         o.Help,
+		Options:      plainOptions(current),
+		Instructions: "Enter numbers or values in the new order separated by commas",
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return o.answers(), nil
+		}
+
+		// numbers refer to the options as they were listed
+		values := []string{}
+		for _, part := range strings.Split(line, ",") {
+			part = strings.TrimSpace(part)
+			if n, err := strconv.Atoi(part); err == nil && n >= 1 && n <= len(current) {
+				part = current[n-1]
+			}
+			values = append(values, part)
+		}
+
+		order, err := arrange(o.Options, o.order, values)
+		if err != nil {
+			return nil, err
+		}
+
+		o.order = order
+		return o.answers(), nil
+	})
+}
+
+// parseAnswer moves the options given by an AnswerSource to the front in the order they were
+// given, either as a list or separated by commas.
+func (o *Order) parseAnswer(value interface{}) (interface{}, error) {
+	values, err := sourceList(value, "an order")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.start(); err != nil {
+		return nil, err
+	}
+	order, err := arrange(o.Options, o.order, values)
+	if err != nil {
+		return nil, err
+	}
+	o.order = order
+	return o.answers(), nil
+}
+
+// defaultAnswer returns the options in the order they start in.
+func (o *Order) defaultAnswer() (interface{}, error) {
+	if err := o.start(); err != nil {
+		return nil, err
+	}
+	return o.answers(), nil
+}
+
+// SetDefault uses the given options as the starting order. It accepts a slice of OptionAnswer or
+// a slice with the values of the options.
+func (o *Order) SetDefault(value interface{}) error {
+	values := []string{}
+	switch v := value.(type) {
+	case []core.OptionAnswer:
+		for _, opt := range v {
+			values = append(values, opt.Value)
+		}
+	case []string:
+		values = v
+	default:
+		return fmt.Errorf("cannot use %T as the default of an order", value)
+	}
+
+	if _, err := arrange(o.Options, nil, values); err != nil {
+		return err
+	}
+	o.Default = values
+	return nil
+}
+
+func (o *Order) Cleanup(config *PromptConfig, val interface{}) error {
+	if !o.interactive() {
+		return nil
+	}
+
+	answers := []string{}
+	for _, opt := range val.([]core.OptionAnswer) {
+		answers = append(answers, opt.Value)
+	}
+
+	return o.Render(
+		OrderQuestionTemplate,
+		OrderTemplateData{
+			Order:      *o,
+			Answer:     strings.Join(answers, ", "),
+			ShowAnswer: true,
+			Config:     config,
+		},
+	)
+}
@@ -0,0 +1,248 @@
+package survey
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestOrderRender(t *testing.T) {
+	prompt := Order{
+		Message: "Order the features:",
+		Options: []string{"search", "export", "dark mode"},
+	}
+
+	helpfulPrompt := prompt
+	helpfulPrompt.Help = "This is helpful"
+
+	tests := []struct {
+		title    string
+		prompt   Order
+		data     OrderTemplateData
+		expected string
+	}{
+		{
+			"Test Order question output",
+			prompt,
+			OrderTemplateData{SelectedIndex: 1, PageEntries: core.OptionAnswerList(prompt.Options)},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Order the features:  [Use arrows to move, space to grab and drop]", defaultIcons().Question.Text),
+					"  search",
+					fmt.Sprintf("%s export", defaultIcons().SelectFocus.Text),
+					"  dark mode\n",
+				},
+				"\n",
+			),
+		},
+		{
+			"Test Order question output with a grabbed option",
+			prompt,
+			OrderTemplateData{SelectedIndex: 1, Grabbed: true, PageEntries: core.OptionAnswerList(prompt.Options)},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Order the features:  [Use arrows to move, space to grab and drop]", defaultIcons().Question.Text),
+					"  search",
+					fmt.Sprintf("%s export", defaultIcons().GrabbedOption.Text),
+					"  dark mode\n",
+				},
+				"\n",
+			),
+		},
+		{
+			"Test Order answer output",
+			prompt,
+			OrderTemplateData{Answer: "export, search, dark mode", ShowAnswer: true},
+			fmt.Sprintf("%s Order the features: export, search, dark mode\n", defaultIcons().Question.Text),
+		},
+		{
+			"Test Order question output with help hidden",
+			helpfulPrompt,
+			OrderTemplateData{PageEntries: core.OptionAnswerList(prompt.Options)},
+			fmt.Sprintf("%s Order the features:  [Use arrows to move, space to grab and drop, %s for more help]", defaultIcons().Question.Text, defaultPromptConfig().HelpInput),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.data.Order = test.prompt
+
+			// set the icon set
+			test.data.Config = defaultPromptConfig()
+
+			err = test.prompt.Render(
+				OrderQuestionTemplate,
+				test.data,
+			)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestOrderPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"basic interaction",
+			&Order{
+				Message: "Order the features:",
+				Options: []string{"search", "export", "dark mode"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Order the features:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{
+				{Value: "search", Index: 0},
+				{Value: "export", Index: 1},
+				{Value: "dark mode", Index: 2},
+			},
+		},
+		{
+			"move an option down",
+			&Order{
+				Message: "Order the features:",
+				Options: []string{"search", "export", "dark mode"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Order the features:")
+				// grab search and move it to the bottom
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowDown))
+				// a grabbed option stops at the end
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{
+				{Value: "export", Index: 1},
+				{Value: "dark mode", Index: 2},
+				{Value: "search", Index: 0},
+			},
+		},
+		{
+			"drop an option and move another one up",
+			&Order{
+				Message: "Order the features:",
+				Options: []string{"search", "export", "dark mode"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Order the features:")
+				// move export to the top
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(" ")
+				// the focus wraps around once nothing is grabbed
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(" ")
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{
+				{Value: "export", Index: 1},
+				{Value: "dark mode", Index: 2},
+				{Value: "search", Index: 0},
+			},
+		},
+		{
+			"default order",
+			&Order{
+				Message: "Order the features:",
+				Options: []string{"search", "export", "dark mode"},
+				Default: []string{"dark mode"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Order the features:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{
+				{Value: "dark mode", Index: 2},
+				{Value: "search", Index: 0},
+				{Value: "export", Index: 1},
+			},
+		},
+		{
+			"prompt for help",
+			&Order{
+				Message: "Order the features:",
+				Options: []string{"search", "export"},
+				Help:    "The first feature is built first",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Order the features:")
+				c.Send("?")
+				c.ExpectString("The first feature is built first")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]core.OptionAnswer{
+				{Value: "search", Index: 0},
+				{Value: "export", Index: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestOrderSetDefault(t *testing.T) {
+	prompt := &Order{Options: []string{"search", "export", "dark mode"}}
+
+	assert.NoError(t, prompt.SetDefault([]string{"export", "search"}))
+	assert.Equal(t, []string{"export", "search"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault([]core.OptionAnswer{{Value: "dark mode", Index: 2}}))
+	assert.Equal(t, []string{"dark mode"}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault([]string{"login"}))
+	assert.Error(t, prompt.SetDefault([]string{"search", "search"}))
+	assert.Error(t, prompt.SetDefault("search"))
+	assert.Equal(t, []string{"dark mode"}, prompt.Default)
+}
+
+func TestOrder_WriteAnswer(t *testing.T) {
+	answers := struct {
+		Names   []string
+		Indices []int
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "names", Prompt: &Order{Options: []string{"search", "export", "dark mode"}}},
+		{Name: "indices", Prompt: &Order{Options: []string{"search", "export", "dark mode"}}},
+	}, &answers, WithAnswerSource(MapSource{
+		"names":   "dark mode, search",
+		"indices": []interface{}{"export"},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"dark mode", "search", "export"}, answers.Names)
+	assert.Equal(t, []int{1, 0, 2}, answers.Indices)
+}
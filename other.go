@@ -0,0 +1,36 @@
+package survey
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// withOther adds the entry that lets the user type their own answer after the options
+func withOther(options []core.OptionAnswer, other string) []core.OptionAnswer {
+	if other == "" {
+		return options
+	}
+	return append(options, core.OptionAnswer{Value: other, Index: core.OtherIndex})
+}
+
+// checkOther makes sure the answer the user typed themselves isn't empty and passes the validator
+func checkOther(value string, validate Validator) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New("please type an answer")
+	}
+	if validate != nil {
+		return validate(value)
+	}
+	return nil
+}
+
+// typedOther returns the answer for a value the user typed themselves
+func typedOther(value string, validate Validator) (core.OptionAnswer, error) {
+	value = strings.TrimSpace(value)
+	if err := checkOther(value, validate); err != nil {
+		return core.OptionAnswer{}, err
+	}
+	return core.OptionAnswer{Value: value, Index: core.OtherIndex}, nil
+}
@@ -36,8 +36,12 @@ var PasswordQuestionTemplate = `
 {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ .Config.HelpInput }} for help]{{color "reset"}} {{end}}`
 
 func (p *Password) Prompt(config *PromptConfig) (interface{}, error) {
+	if !p.interactive() {
+		return p.promptPlain(config)
+	}
+
 	// render the question template
-	userOut, _, err := core.RunTemplate(
+	userOut, layoutOut, err := core.RunTemplate(
 		PasswordQuestionTemplate,
 		PasswordTemplateData{
 			Password: *p,
@@ -51,6 +55,7 @@ func (p *Password) Prompt(config *PromptConfig) (interface{}, error) {
 	if _, err := fmt.Fprint(terminal.NewAnsiStdout(p.Stdio().Out), userOut); err != nil {
 		return "", err
 	}
+	p.AppendRenderedText(layoutOut)
 
 	rr := p.NewRuneReader()
 	_ = rr.SetTermMode()
@@ -58,23 +63,17 @@ func (p *Password) Prompt(config *PromptConfig) (interface{}, error) {
 		_ = rr.RestoreTermMode()
 	}()
 
-	// no help msg?  Just return any response
-	if p.Help == "" {
-		line, err := rr.ReadLine(config.HideCharacter)
-		return string(line), err
-	}
-
 	cursor := p.NewCursor()
 
 	var line []rune
 	// process answers looking for help prompt answer
 	for {
-		line, err = rr.ReadLine(config.HideCharacter)
+		line, err = rr.ReadLine(config.HideCharacter, goBackOnRune(config))
 		if err != nil {
 			return string(line), err
 		}
 
-		if string(line) == config.HelpInput {
+		if string(line) == config.HelpInput && p.Help != "" {
 			// terminal will echo the \n so we need to jump back up one row
 			cursor.PreviousLine(1)
 
@@ -96,10 +95,31 @@ func (p *Password) Prompt(config *PromptConfig) (interface{}, error) {
 	}
 
 	lineStr := string(line)
-	p.AppendRenderedText(strings.Repeat(string(config.HideCharacter), len(lineStr)))
+	// the terminal echoed the \n so the answer ends the rendered line
+	p.AppendRenderedText(strings.Repeat(string(config.HideCharacter), len(lineStr)) + "\n")
 	return lineStr, err
 }
 
+// promptPlain reads the password like any other line, since there is no terminal to stop
+// echoing it.
+func (p *Password) promptPlain(config *PromptConfig) (interface{}, error) {
+	return p.askPlain(config, PlainTemplateData{
+		Message: p.Message,
+		Help:    p.Help,
+	}, func(line string) (interface{}, error) {
+		return line, nil
+	})
+}
+
+func (p *Password) parseAnswer(value interface{}) (interface{}, error) {
+	return textAnswer(value), nil
+}
+
+// defaultAnswer returns an empty password since passwords have no default.
+func (p *Password) defaultAnswer() (interface{}, error) {
+	return "", nil
+}
+
 // Cleanup hides the string with a fixed number of characters.
 func (prompt *Password) Cleanup(config *PromptConfig, val interface{}) error {
 	return nil
@@ -0,0 +1,212 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+Path is an input for file system paths. Tab completes the path with the entries of the directory
+being typed, showing a list to pick from when there is more than one. A leading ~ is expanded to
+the home directory of the user. Response type is a string with the cleaned path.
+
+	config := ""
+	prompt := &survey.Path{
+		Message:    "Which config file should be used?",
+		Extensions: []string{".yaml", ".yml"},
+		MustExist:  true,
+	}
+	survey.AskOne(prompt, &config)
+*/
+type Path struct {
+	Message string
+	Default string
+	Help    string
+	// DirsOnly only accepts directories
+	DirsOnly bool
+	// Extensions lists the extensions files are allowed to have, like ".yaml"
+	Extensions []string
+	// MustExist only accepts paths that exist
+	MustExist bool
+	// MustNotExist only accepts paths that don't exist yet
+	MustNotExist bool
+	input        Input
+}
+
+// WithStdio sets the stdio of the input used to ask for the path.
+func (p *Path) WithStdio(stdio terminal.Stdio) {
+	p.input.WithStdio(stdio)
+}
+
+func (p *Path) Prompt(config *PromptConfig) (interface{}, error) {
+	p.input.Message = p.Message
+	p.input.Default = p.Default
+	p.input.Help = p.Help
+	p.input.Suggest = p.suggest
+
+	for {
+		ans, err := p.input.Prompt(config)
+		if err != nil {
+			return "", err
+		}
+
+		path, err := p.check(ans.(string))
+		if err == nil {
+			return path, nil
+		}
+
+		// tell the user what is wrong with the path and ask again
+		if err := p.input.Error(config, err); err != nil {
+			return "", err
+		}
+	}
+}
+
+// Error shows the error on the input used to ask for the path.
+func (p *Path) Error(config *PromptConfig, err error) error {
+	return p.input.Error(config, err)
+}
+
+// Cleanup shows the answer on the input used to ask for the path.
+func (p *Path) Cleanup(config *PromptConfig, val interface{}) error {
+	return p.input.Cleanup(config, fmt.Sprint(val))
+}
+
+// clearRendered removes the input used to ask for the path.
+func (p *Path) clearRendered() {
+	p.input.clearRendered()
+}
+
+func (p *Path) SetDefault(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("cannot use %T as the default of a path", value)
+	}
+	p.Default = str
+	return nil
+}
+
+// parseAnswer checks the path given by an AnswerSource against the filters of the prompt.
+func (p *Path) parseAnswer(value interface{}) (interface{}, error) {
+	if str, ok := value.(string); ok {
+		return p.check(str)
+	}
+	return nil, fmt.Errorf("cannot use %T as the answer to a path", value)
+}
+
+// defaultAnswer returns the default path if it passes the filters of the prompt.
+func (p *Path) defaultAnswer() (interface{}, error) {
+	return p.check(p.Default)
+}
+
+// expandHome replaces a leading ~ with the home directory of the user
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~"+string(filepath.Separator)) && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return home + path[1:]
+}
+
+// check returns the cleaned path or an error if it doesn't pass the filters of the prompt
+func (p *Path) check(answer string) (string, error) {
+	// an empty answer can't name a path that exists, otherwise it is up to the Required validator
+	if answer == "" {
+		if p.MustExist {
+			return "", errors.New("the path must exist")
+		}
+		return "", nil
+	}
+
+	path := filepath.Clean(expandHome(answer))
+
+	info, err := os.Stat(path)
+	exists := err == nil
+
+	switch {
+	case p.MustExist && !exists:
+		return "", fmt.Errorf("%s does not exist", answer)
+	case p.MustNotExist && exists:
+		return "", fmt.Errorf("%s already exists", answer)
+	case p.DirsOnly && exists && !info.IsDir():
+		return "", fmt.Errorf("%s is not a directory", answer)
+	case !p.DirsOnly && !(exists && info.IsDir()) && !p.allowedExtension(path):
+		return "", fmt.Errorf("%s must end with one of %s", answer, strings.Join(p.Extensions, ", "))
+	}
+
+	return path, nil
+}
+
+// allowedExtension returns whether a file can be picked given the allowed extensions
+func (p *Path) allowedExtension(name string) bool {
+	if len(p.Extensions) == 0 {
+		return true
+	}
+	ext := filepath.Ext(name)
+	for _, allowed := range p.Extensions {
+		if strings.EqualFold(ext, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggest lists the entries of the directory being typed that start with the last segment
+func (p *Path) suggest(toComplete string) []string {
+	// split what was typed into the directory and the start of the name of an entry
+	prefix, base := "", toComplete
+	if i := strings.LastIndexAny(toComplete, "/"+string(filepath.Separator)); i >= 0 {
+		prefix, base = toComplete[:i+1], toComplete[i+1:]
+	} else if toComplete == "~" {
+		prefix, base = "~/", ""
+	}
+
+	dir := expandHome(prefix)
+	if dir == "" {
+		dir = "."
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	suggestions := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, base) {
+			continue
+		}
+		// only show hidden entries when asked for
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(base, ".") {
+			continue
+		}
+
+		// follow links to find out what they point to
+		isDir := entry.IsDir()
+		if entry.Mode()&os.ModeSymlink != 0 {
+			if info, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				isDir = info.IsDir()
+			}
+		}
+
+		if isDir {
+			suggestions = append(suggestions, prefix+name+string(filepath.Separator))
+		} else if !p.DirsOnly && p.allowedExtension(name) {
+			suggestions = append(suggestions, prefix+name)
+		}
+	}
+	sort.Strings(suggestions)
+
+	return suggestions
+}
@@ -0,0 +1,242 @@
+package survey
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+)
+
+// pathTestDir creates a directory with a few files and folders to pick from
+func pathTestDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "survey-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"config.yaml", "config.json", "notes.txt", ".hidden"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"conf.d", "logs"} {
+		if err := os.Mkdir(filepath.Join(dir, name), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestPathSuggest(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+
+	sep := string(filepath.Separator)
+
+	tests := []struct {
+		title    string
+		prompt   Path
+		typed    string
+		expected []string
+	}{
+		{
+			"Test Path suggests entries starting with the typed name",
+			Path{},
+			dir + sep + "conf",
+			[]string{dir + sep + "conf.d" + sep, dir + sep + "config.json", dir + sep + "config.yaml"},
+		},
+		{
+			"Test Path suggests the entries of a directory",
+			Path{},
+			dir + sep,
+			[]string{dir + sep + "conf.d" + sep, dir + sep + "config.json", dir + sep + "config.yaml", dir + sep + "logs" + sep, dir + sep + "notes.txt"},
+		},
+		{
+			"Test Path suggests hidden entries when asked for",
+			Path{},
+			dir + sep + ".",
+			[]string{dir + sep + ".hidden"},
+		},
+		{
+			"Test Path suggests directories only",
+			Path{DirsOnly: true},
+			dir + sep,
+			[]string{dir + sep + "conf.d" + sep, dir + sep + "logs" + sep},
+		},
+		{
+			"Test Path suggests files with allowed extensions",
+			Path{Extensions: []string{".yaml", ".yml"}},
+			dir + sep + "conf",
+			[]string{dir + sep + "conf.d" + sep, dir + sep + "config.yaml"},
+		},
+		{
+			"Test Path suggests nothing in a missing directory",
+			Path{},
+			dir + sep + "missing" + sep,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.prompt.suggest(test.typed))
+		})
+	}
+}
+
+func TestPathSuggest_Home(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+
+	home := os.Getenv("HOME")
+	os.Setenv("HOME", dir)
+	defer os.Setenv("HOME", home)
+
+	sep := string(filepath.Separator)
+
+	prompt := &Path{}
+	assert.Equal(t, []string{"~" + sep + "logs" + sep}, prompt.suggest("~"+sep+"lo"))
+
+	path, err := prompt.check("~" + sep + "logs" + sep)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "logs"), path)
+}
+
+func TestPathCheck(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "config.yaml")
+	notes := filepath.Join(dir, "notes.txt")
+	missing := filepath.Join(dir, "missing.yaml")
+
+	tests := []struct {
+		title    string
+		prompt   Path
+		answer   string
+		expected string
+		err      string
+	}{
+		{"Test Path cleans the answer", Path{}, dir + "/logs/../config.yaml", config, ""},
+		{"Test Path accepts an empty answer", Path{Extensions: []string{".yaml"}}, "", "", ""},
+		{"Test Path must exist when empty", Path{MustExist: true}, "", "", "the path must exist"},
+		{"Test Path must exist", Path{MustExist: true}, missing, "", missing + " does not exist"},
+		{"Test Path must not exist", Path{MustNotExist: true}, config, "", config + " already exists"},
+		{"Test Path accepts a new file", Path{MustNotExist: true}, missing, missing, ""},
+		{"Test Path directories only", Path{DirsOnly: true}, notes, "", notes + " is not a directory"},
+		{"Test Path extensions", Path{Extensions: []string{".yaml", ".yml"}}, notes, "", notes + " must end with one of .yaml, .yml"},
+		{"Test Path extensions allow directories", Path{Extensions: []string{".yaml"}}, dir, dir, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			path, err := test.prompt.check(test.answer)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, path)
+		})
+	}
+}
+
+func TestPathPrompt(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+
+	sep := string(filepath.Separator)
+
+	tests := []PromptTest{
+		{
+			"Test Path prompt interaction",
+			&Path{Message: "Config file:"},
+			func(c expectConsole) {
+				c.ExpectString("Config file:")
+				c.SendLine(dir + sep + "config.yaml")
+				c.ExpectEOF()
+			},
+			filepath.Join(dir, "config.yaml"),
+		},
+		{
+			"Test Path prompt completes a single match",
+			&Path{Message: "Config file:"},
+			func(c expectConsole) {
+				c.ExpectString("Config file:")
+				c.Send(dir + sep + "no")
+				c.Send(string(terminal.KeyTab))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			filepath.Join(dir, "notes.txt"),
+		},
+		{
+			"Test Path prompt browses the listing of a directory",
+			&Path{Message: "Config file:", Extensions: []string{".json"}},
+			func(c expectConsole) {
+				c.ExpectString("Config file:")
+				c.Send(dir + sep + "conf")
+				c.Send(string(terminal.KeyTab))
+				c.ExpectString("conf.d")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			filepath.Join(dir, "config.json"),
+		},
+		{
+			"Test Path prompt asks again for a missing path",
+			&Path{Message: "Config file:", MustExist: true},
+			func(c expectConsole) {
+				c.ExpectString("Config file:")
+				c.SendLine(dir + sep + "missing.yaml")
+				c.ExpectString("missing.yaml does not exist")
+				c.SendLine(dir + sep + "logs" + sep)
+				c.ExpectEOF()
+			},
+			filepath.Join(dir, "logs"),
+		},
+	}
+
+	// the tests run in parallel so wait for all of them before removing the directory
+	t.Run("group", func(t *testing.T) {
+		for _, test := range tests {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				RunPromptTest(t, test)
+			})
+		}
+	})
+}
+
+func TestPathPlain(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+
+	missing := filepath.Join(dir, "missing")
+
+	var answer interface{}
+	output := runPlainTest(t, missing+"\n"+dir+"/logs\n", func(stdio terminal.Stdio) error {
+		prompt := &Path{Message: "Log directory:", DirsOnly: true, MustExist: true}
+		prompt.WithStdio(stdio)
+
+		var err error
+		answer, err = prompt.Prompt(defaultPromptConfig())
+		return err
+	})
+
+	assert.Equal(t, filepath.Join(dir, "logs"), answer)
+	assert.Equal(t, "? Log directory: \nX Sorry, your reply was invalid: "+missing+" does not exist\n? Log directory: \n", output)
+}
+
+func TestPathSetDefault(t *testing.T) {
+	prompt := &Path{}
+
+	assert.NoError(t, prompt.SetDefault("~/.config"))
+	assert.Equal(t, "~/.config", prompt.Default)
+	assert.Error(t, prompt.SetDefault(3))
+}
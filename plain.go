@@ -0,0 +1,56 @@
+package survey
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// data available to the templates used when a prompt is not interactive
+type PlainTemplateData struct {
+	Message      string
+	Help         string
+	ShowHelp     bool
+	Options      []PlainOption
+	Instructions string
+	Default      string
+	Config       *PromptConfig
+}
+
+// PlainOption is a numbered option shown when a prompt is not interactive
+type PlainOption struct {
+	Number int
+	Value  string
+	// Group is shown above the first option of a group
+	Group string
+}
+
+// PlainQuestionTemplate is used by every prompt when it is not reading from a terminal.
+// It prints the question and the numbered options without any escape sequences.
+var PlainQuestionTemplate = `
+{{- if .ShowHelp }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{"\n"}}{{end}}
+{{- .Config.Icons.Question.Text }} {{ .Message }}
+{{- range .Options}}{{if .Group}}{{"\n"}}  {{ .Group }}{{end}}{{"\n"}}  {{ .Number }}) {{ .Value }}{{end}}
+{{- if .Options}}{{"\n"}} {{end}}
+{{- if and .Help (not .ShowHelp)}} [{{ .Config.HelpInput }} for help]{{end}}
+{{- if .Instructions}} {{ .Instructions }}{{end}}
+{{- if .Default}} ({{ .Default }}){{end}} `
+
+// plainOptions numbers the options for the plain template
+func plainOptions(options []string) []PlainOption {
+	plain := []PlainOption{}
+	for i, opt := range options {
+		plain = append(plain, PlainOption{Number: i + 1, Value: opt})
+	}
+	return plain
+}
+
+// parsePlainOption returns the option the user picked by typing either its number or its value.
+func parsePlainOption(options []string, input string) (core.OptionAnswer, error) {
+	input = strings.TrimSpace(input)
+	if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(options) {
+		return core.OptionAnswer{Value: options[n-1], Index: n - 1}, nil
+	}
+	return findOption(options, input)
+}
@@ -0,0 +1,349 @@
+package survey
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runPlainTest runs the test with stdin being a pipe that holds the given input
+// and returns everything written to stdout
+func runPlainTest(t *testing.T, input string, test func(terminal.Stdio) error) string {
+	t.Helper()
+
+	in, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer in.Close()
+
+	_, err = w.WriteString(input)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	out, err := ioutil.TempFile("", "survey")
+	require.NoError(t, err)
+	defer os.Remove(out.Name())
+	defer out.Close()
+
+	require.NoError(t, test(terminal.Stdio{In: in, Out: out, Err: out}))
+
+	_, err = out.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	output, err := ioutil.ReadAll(out)
+	require.NoError(t, err)
+
+	return string(output)
+}
+
+func TestPlainPrompts(t *testing.T) {
+	tests := []struct {
+		name     string
+		prompt   Prompt
+		input    string
+		expected interface{}
+		output   string
+	}{
+		{
+			"input",
+			&Input{Message: "What is your name?"},
+			"Johnny\n",
+			"Johnny",
+			"? What is your name? \n",
+		},
+		{
+			"input default",
+			&Input{Message: "What is your name?", Default: "Johnny"},
+			"\n",
+			"Johnny",
+			"? What is your name? (Johnny) \n",
+		},
+		{
+			"input help",
+			&Input{Message: "What is your name?", Help: "It's your name"},
+			"?\r\nJohnny",
+			"Johnny",
+			"? What is your name? [? for help] \nⓘ It's your name\n? What is your name? \n",
+		},
+		{
+			"password",
+			&Password{Message: "Password:"},
+			"secret\n",
+			"secret",
+			"? Password: \n",
+		},
+		{
+			"confirm",
+			&Confirm{Message: "Do you like pie?"},
+			"maybe\nyes\n",
+			true,
+			"? Do you like pie? (y/N) \nX Sorry, your reply was invalid: \"maybe\" is not a valid answer, please try again.\n? Do you like pie? (y/N) \n",
+		},
+		{
+			"confirm default",
+			&Confirm{Message: "Do you like pie?", Default: true},
+			"\n",
+			true,
+			"? Do you like pie? (Y/n) \n",
+		},
+		{
+			"number",
+			&Number{Message: "How many replicas?", Default: 3, Min: bound(1), Max: bound(10)},
+			"11\n5\n",
+			5,
+			"? How many replicas? [1 to 10] (3) \nX Sorry, your reply was invalid: 11 is not between 1 and 10\n? How many replicas? [1 to 10] (3) \n",
+		},
+		{
+			"date",
+			&DateTime{Message: "When?", Default: date(2026, time.October, 8)},
+			"tomorrow\n2026-12-24\n",
+			date(2026, time.December, 24),
+			"? When? 2006-01-02 (2026-10-08) \nX Sorry, your reply was invalid: \"tomorrow\" does not match 2006-01-02\n? When? 2006-01-02 (2026-10-08) \n",
+		},
+		{
+			"editor",
+			&Editor{Message: "Commit message:"},
+			"Add plain prompts\n",
+			"Add plain prompts",
+			"? Commit message: \n",
+		},
+		{
+			"multiline",
+			&Multiline{Message: "Description:"},
+			"first\nsecond\n\n\n",
+			"first\nsecond",
+			"? Description: [Enter 2 empty lines to finish] \n\n\n\n",
+		},
+		{
+			"multiline end of input",
+			&Multiline{Message: "Description:"},
+			"first\nsecond",
+			"first\nsecond",
+			"? Description: [Enter 2 empty lines to finish] \n\n",
+		},
+		{
+			"select number",
+			&Select{Message: "Choose a color:", Options: []string{"red", "blue", "green"}},
+			"2\n",
+			core.OptionAnswer{Value: "blue", Index: 1},
+			"? Choose a color:\n  1) red\n  2) blue\n  3) green\n  Enter a number or value (red) \n",
+		},
+		{
+			"select value",
+			&Select{Message: "Choose a color:", Options: []string{"red", "blue", "green"}},
+			"purple\ngreen\n",
+			core.OptionAnswer{Value: "green", Index: 2},
+			"? Choose a color:\n  1) red\n  2) blue\n  3) green\n  Enter a number or value (red) \n" +
+				"X Sorry, your reply was invalid: \"purple\" is not one of the options\n" +
+				"? Choose a color:\n  1) red\n  2) blue\n  3) green\n  Enter a number or value (red) \n",
+		},
+		{
+			"select default",
+			&Select{Message: "Choose a color:", Options: []string{"red", "blue", "green"}, Default: "blue"},
+			"\n",
+			core.OptionAnswer{Value: "blue", Index: 1},
+			"? Choose a color:\n  1) red\n  2) blue\n  3) green\n  Enter a number or value (blue) \n",
+		},
+		{
+			"select other",
+			&Select{Message: "Choose a color:", Options: []string{"red", "blue"}, Other: "Other..."},
+			"purple\n",
+			core.OptionAnswer{Value: "purple", Index: core.OtherIndex},
+			"? Choose a color:\n  1) red\n  2) blue\n  Enter a number, a value or your own answer (red) \n",
+		},
+		{
+			"multiselect other",
+			&MultiSelect{Message: "Hobbies:", Options: []string{"chess", "reading"}, Other: "Other:"},
+			"hiking, 1, sailing\nhiking, 1\n",
+			[]core.OptionAnswer{{Value: "chess", Index: 0}, {Value: "hiking", Index: core.OtherIndex}},
+			"? Hobbies:\n  1) chess\n  2) reading\n  Enter numbers, values or your own answer separated by commas \n" +
+				"X Sorry, your reply was invalid: \"sailing\" is not one of the options\n" +
+				"? Hobbies:\n  1) chess\n  2) reading\n  Enter numbers, values or your own answer separated by commas \n",
+		},
+		{
+			"select choices",
+			&Select{Message: "Choose an environment:", Choices: environmentChoices()},
+			"prod\n4\n",
+			core.OptionAnswer{Value: "demo", Index: 3},
+			"? Choose an environment:\n  Internal\n  1) Development\n  2) Staging\n  Public\n  3) Production (no permission)\n  4) Demo\n  Enter a number or value (dev) \n" +
+				"X Sorry, your reply was invalid: \"prod\" can't be chosen: no permission\n" +
+				"? Choose an environment:\n  Internal\n  1) Development\n  2) Staging\n  Public\n  3) Production (no permission)\n  4) Demo\n  Enter a number or value (dev) \n",
+		},
+		{
+			"multiselect disabled default",
+			&MultiSelect{Message: "Deploy to:", Choices: environmentChoices(), Default: []int{2}},
+			"1\n",
+			[]core.OptionAnswer{{Value: "dev", Index: 0}, {Value: "prod", Index: 2}},
+			"? Deploy to:\n  Internal\n  1) Development\n  2) Staging\n  Public\n  3) Production (no permission)\n  4) Demo\n  Enter numbers or values separated by commas (prod) \n",
+		},
+		{
+			"select loaded options",
+			&Select{Message: "Choose a branch:", LoadOptions: branchLoader},
+			"feature-login\n",
+			core.OptionAnswer{Value: "feature-login", Index: 2},
+			"? Choose a branch:\n  1) main\n  2) develop\n  3) feature-login\n  4) feature-search\n  Enter a number or value (main) \n",
+		},
+		{
+			"multiselect",
+			&MultiSelect{Message: "Days:", Options: []string{"Sunday", "Monday", "Tuesday"}},
+			"3, Sunday\n",
+			[]core.OptionAnswer{{Value: "Sunday", Index: 0}, {Value: "Tuesday", Index: 2}},
+			"? Days:\n  1) Sunday\n  2) Monday\n  3) Tuesday\n  Enter numbers or values separated by commas \n",
+		},
+		{
+			"multiselect default",
+			&MultiSelect{Message: "Days:", Options: []string{"Sunday", "Monday", "Tuesday"}, Default: []int{1}},
+			"\n",
+			[]core.OptionAnswer{{Value: "Monday", Index: 1}},
+			"? Days:\n  1) Sunday\n  2) Monday\n  3) Tuesday\n  Enter numbers or values separated by commas (Monday) \n",
+		},
+		{
+			"order",
+			&Order{Message: "Order the features:", Options: []string{"search", "export", "dark mode"}},
+			"3, login\n3\n",
+			[]core.OptionAnswer{{Value: "dark mode", Index: 2}, {Value: "search", Index: 0}, {Value: "export", Index: 1}},
+			"? Order the features:\n  1) search\n  2) export\n  3) dark mode\n  Enter numbers or values in the new order separated by commas \n" +
+				"X Sorry, your reply was invalid: \"login\" is not one of the options\n" +
+				"? Order the features:\n  1) search\n  2) export\n  3) dark mode\n  Enter numbers or values in the new order separated by commas \n",
+		},
+		{
+			"tags",
+			&Tags{Message: "Which hosts?", Default: []string{"web-1"}},
+			"web-1, db, web-1\nweb-1, db\n",
+			[]string{"web-1", "db"},
+			"? Which hosts? Enter values separated by commas (web-1) \n" +
+				"X Sorry, your reply was invalid: \"web-1\" was already added\n" +
+				"? Which hosts? Enter values separated by commas (web-1) \n",
+		},
+		{
+			"key value",
+			&KeyValue{Message: "Environment variables:", Default: map[string]string{"PORT": "8080"}},
+			"DEBUG=true\nPORT\nPORT = 9090\nDEBUG=false\n\n",
+			map[string]string{"DEBUG": "true", "PORT": "9090"},
+			"? Environment variables: [Enter key=value pairs on separate lines and an empty line to finish] (PORT=8080) \n\n" +
+				"X Sorry, your reply was invalid: \"PORT\" is not a key=value pair\n\n\n" +
+				"X Sorry, your reply was invalid: \"DEBUG\" is used more than once\n\n",
+		},
+		{
+			"table select",
+			&TableSelect{Message: "Choose an instance:", Headers: instanceHeaders, Rows: instanceRows()},
+			"us-east-1\n2\n",
+			core.OptionAnswer{Value: "db-1", Index: 1},
+			"? Choose an instance:\n  1) web-1     us-east-1  2\n  2) db-1      eu-west-1  16\n  3) worker-1  us-west-2  4\n  Enter a number or value (web-1) \n" +
+				"X Sorry, your reply was invalid: \"us-east-1\" is not in the table\n" +
+				"? Choose an instance:\n  1) web-1     us-east-1  2\n  2) db-1      eu-west-1  16\n  3) worker-1  us-west-2  4\n  Enter a number or value (web-1) \n",
+		},
+		{
+			"multi table select",
+			&MultiTableSelect{Message: "Which instances?", Headers: instanceHeaders, Rows: instanceRows(), Default: []int{0}},
+			"3, db-1\n",
+			[]core.OptionAnswer{{Value: "db-1", Index: 1}, {Value: "worker-1", Index: 2}},
+			"? Which instances?\n  1) web-1     us-east-1  2\n  2) db-1      eu-west-1  16\n  3) worker-1  us-west-2  4\n  Enter numbers or values separated by commas (web-1) \n",
+		},
+		{
+			"cascade",
+			&Cascade{Message: "Choose a zone:", Levels: locationLevels()},
+			"Germany\n3\nfrankfurt\n3\n",
+			core.TreeAnswer{Value: "frankfurt-c", Path: []string{"Germany", "Hesse", "frankfurt-c"}, Index: 2},
+			"? Choose a zone:\n  1) France\n  2) Germany\n  3) Monaco\n  Enter a number or value (France) \n" +
+				"? Choose a zone: Germany\n  1) Bavaria\n  2) Berlin\n  3) Hesse\n  Enter a number or value (Bavaria) \n" +
+				"? Choose a zone: Germany/Hesse\n  1) frankfurt-a\n  2) frankfurt-b\n  3) frankfurt-c\n  Enter a number or value (frankfurt-a) \n" +
+				"X Sorry, your reply was invalid: \"frankfurt\" is not one of the options\n" +
+				"? Choose a zone: Germany/Hesse\n  1) frankfurt-a\n  2) frankfurt-b\n  3) frankfurt-c\n  Enter a number or value (frankfurt-a) \n",
+		},
+		{
+			"tree select",
+			&TreeSelect{Message: "Choose a resource:", Options: resourceTree()},
+			"web\ndefault/services/web\n",
+			core.TreeAnswer{Value: "web", Path: []string{"default", "services", "web"}, Index: 5},
+			"? Choose a resource:\n  1) default\n  2)   deployments\n  3)     web\n  4)     worker\n  5)   services\n  6)     web\n  7) kube-system\n  8)   pods\n  9)     coredns\n  Enter a number or path (default) \n" +
+				"X Sorry, your reply was invalid: \"web\" is in the tree more than once, use its path instead\n" +
+				"? Choose a resource:\n  1) default\n  2)   deployments\n  3)     web\n  4)     worker\n  5)   services\n  6)     web\n  7) kube-system\n  8)   pods\n  9)     coredns\n  Enter a number or path (default) \n",
+		},
+		{
+			"multi tree select",
+			&MultiTreeSelect{Message: "Choose a resource:", Options: resourceTree(), Default: [][]string{{"default"}}},
+			"2, coredns\n",
+			[]core.TreeAnswer{
+				{Value: "deployments", Path: []string{"default", "deployments"}, Index: 1},
+				{Value: "coredns", Path: []string{"kube-system", "pods", "coredns"}, Index: 8},
+			},
+			"? Choose a resource:\n  1) default\n  2)   deployments\n  3)     web\n  4)     worker\n  5)   services\n  6)     web\n  7) kube-system\n  8)   pods\n  9)     coredns\n  Enter numbers or paths separated by commas (default) \n",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var answer interface{}
+			output := runPlainTest(t, test.input, func(stdio terminal.Stdio) error {
+				test.prompt.(wantsStdio).WithStdio(stdio)
+
+				config := defaultPromptConfig()
+				config.Icons.Help.Text = "ⓘ"
+
+				var err error
+				answer, err = test.prompt.Prompt(config)
+				return err
+			})
+
+			assert.Equal(t, test.expected, answer)
+			assert.Equal(t, test.output, output)
+		})
+	}
+}
+
+func TestPlainAsk(t *testing.T) {
+	answers := struct {
+		Name  string
+		Color string
+		Pie   bool
+	}{}
+
+	output := runPlainTest(t, "Johnny\n2\ny\n", func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{
+				Name:     "name",
+				Prompt:   &Input{Message: "What is your name?"},
+				Validate: Required,
+			},
+			{
+				Name:   "color",
+				Prompt: &Select{Message: "Choose a color:", Options: []string{"red", "blue"}},
+			},
+			{
+				Name:   "pie",
+				Prompt: &Confirm{Message: "Do you like pie?"},
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err))
+	})
+
+	assert.Equal(t, "Johnny", answers.Name)
+	assert.Equal(t, "blue", answers.Color)
+	assert.True(t, answers.Pie)
+	assert.NotContains(t, output, "\x1b")
+}
+
+func TestPlainAsk_EndOfInput(t *testing.T) {
+	answers := map[string]interface{}{}
+
+	runPlainTest(t, "\n", func(stdio terminal.Stdio) error {
+		err := Ask([]*Question{
+			{
+				Name:     "name",
+				Prompt:   &Input{Message: "What is your name?"},
+				Validate: Required,
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err))
+
+		assert.Equal(t, io.EOF, err)
+		return nil
+	})
+
+	assert.Empty(t, answers)
+}
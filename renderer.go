@@ -3,6 +3,9 @@ package survey
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"strings"
+
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"golang.org/x/term"
@@ -42,6 +45,14 @@ func (r *Renderer) NewCursor() *terminal.Cursor {
 }
 
 func (r *Renderer) Error(config *PromptConfig, invalid error) error {
+	// without a terminal we can only print the error after what we printed before
+	if !r.interactive() {
+		return r.renderPlain(ErrorTemplate, &ErrorTemplateData{
+			Error: invalid,
+			Icon:  config.Icons.Error,
+		})
+	}
+
 	// cleanup the currently rendered errors
 	r.resetPrompt(r.countLines(r.renderedErrors))
 	r.renderedErrors.Reset()
@@ -116,6 +127,92 @@ func (r *Renderer) RenderWithCursorOffset(tmpl string, data IterableOpts, opts [
 	return nil
 }
 
+// interactive returns whether the prompt reads its input from a terminal. Without one the keys
+// can't be read as they are pressed, so every prompt checks this before anything else and asks
+// its question with its promptPlain method instead, which prints plain text and reads whole
+// lines. Most of them leave the reading to askPlain and only turn the line into their answer.
+func (r *Renderer) interactive() bool {
+	if r.stdio.In == nil {
+		return false
+	}
+	return term.IsTerminal(int(r.stdio.In.Fd()))
+}
+
+// renderPlain prints the template without any colors or cursor movements,
+// for use when the prompt is not interactive.
+func (r *Renderer) renderPlain(tmpl string, data interface{}) error {
+	_, layoutOut, err := core.RunTemplate(tmpl, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(r.stdio.Out, layoutOut)
+	return err
+}
+
+// readLine reads a single line of input when the prompt is not interactive. It reads
+// one byte at a time so that nothing meant for the next prompt is consumed.
+func (r *Renderer) readLine() (string, error) {
+	line := []byte{}
+	b := make([]byte, 1)
+	for {
+		n, err := r.stdio.In.Read(b)
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+		if err == io.EOF && len(line) > 0 {
+			// the last line doesn't need to end in a newline
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// the input isn't echoed so move past the question ourselves
+	if _, err := fmt.Fprintln(r.stdio.Out); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
+
+// askPlain asks a question without a terminal. It prints the plain template with the data and
+// reads a line until parse turns it into an answer, showing the help when the user asks for it
+// and the error of parse before asking again. parse is also given the empty line, which usually
+// stands for the default answer.
+func (r *Renderer) askPlain(config *PromptConfig, data PlainTemplateData, parse func(line string) (interface{}, error)) (interface{}, error) {
+	data.Config = config
+	for {
+		if err := r.renderPlain(PlainQuestionTemplate, data); err != nil {
+			return nil, err
+		}
+
+		line, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		// if we ran into the help string
+		if line == config.HelpInput && data.Help != "" {
+			data.ShowHelp = true
+			continue
+		}
+
+		ans, err := parse(line)
+		if err != nil {
+			if err := r.Error(config, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return ans, nil
+	}
+}
+
 // appendRenderedError appends text to the renderer's error buffer
 // which is used to track what has been printed. It is not exported
 // as errors should only be displayed via Error(config, error).
@@ -130,6 +227,16 @@ func (r *Renderer) AppendRenderedText(text string) {
 	r.renderedText.WriteString(text)
 }
 
+// clearRendered erases the prompt and any errors that have been printed
+// so that the space can be reused, for example when going back to a previous question.
+func (r *Renderer) clearRendered() {
+	r.resetPrompt(r.countLines(r.renderedText))
+	r.renderedText.Reset()
+
+	r.resetPrompt(r.countLines(r.renderedErrors))
+	r.renderedErrors.Reset()
+}
+
 func (r *Renderer) resetPrompt(lines int) {
 	// clean out current line in case tmpl didnt end in newline
 	cursor := r.NewCursor()
@@ -0,0 +1,175 @@
+package survey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+/*
+Repeat is a prompt that asks a group of questions over and over to build up a list, for example
+one item for every port to forward. The answer is a []map[string]interface{} with the answers to
+every round keyed by question name, which can be written to a slice of structs or maps. Repeat
+stops after Count rounds or, when Count is zero, when the user answers no to Another. Another is
+not asked for the first Min rounds, and going back from it asks the last round again.
+
+	ports := []struct {
+		Host      int
+		Container int
+	}{}
+	prompt := &survey.Repeat{
+		Questions: []*survey.Question{
+			{Name: "host", Prompt: &survey.Input{Message: "Host port:"}},
+			{Name: "container", Prompt: &survey.Input{Message: "Container port:"}},
+		},
+		Another: &survey.Confirm{Message: "Forward a port?"},
+	}
+	survey.AskOne(prompt, &ports)
+*/
+type Repeat struct {
+	Renderer
+	Questions []*Question
+	Count     int
+	Min       int
+	Another   *Confirm
+}
+
+// Prompt asks the questions without any of the options given to Ask. Use Ask or AskOne instead.
+func (r *Repeat) Prompt(config *PromptConfig) (interface{}, error) {
+	return r.ask(context.Background(), &AskOptions{Stdio: r.Stdio(), PromptConfig: *config})
+}
+
+// Cleanup does nothing since every question in the group cleans up after itself.
+func (r *Repeat) Cleanup(config *PromptConfig, val interface{}) error {
+	return nil
+}
+
+// ask runs the rounds of questions until the group is done.
+func (r *Repeat) ask(ctx context.Context, options *AskOptions) ([]map[string]interface{}, error) {
+	if len(r.Questions) == 0 {
+		return nil, errors.New("please provide questions to repeat")
+	}
+
+	// the answers of every round are reviewed with the rest of the answers
+	nested := *options
+	nested.Review = false
+
+	another := r.Another
+	if another == nil {
+		another = &Confirm{Message: "Add another?"}
+	}
+
+	// the last item is asked again with its answers as the defaults
+	again := nested
+	again.DefaultsFromResponse = true
+
+	items := []map[string]interface{}{}
+	for {
+		if r.Count > 0 && len(items) >= r.Count {
+			break
+		}
+
+		item := map[string]interface{}{}
+		itemOptions := &nested
+
+		// ask the user whether to keep going once we have enough items
+		if r.Count == 0 && len(items) >= r.Min {
+			_, more, err := askQuestion(&Question{Prompt: another}, &nested, nil)
+			if err == ErrGoBack && len(items) > 0 {
+				// going back returns to the last item instead of the question before the group
+				item = items[len(items)-1]
+				items = items[:len(items)-1]
+				itemOptions = &again
+			} else if err != nil {
+				return nil, err
+			} else if !more.(bool) {
+				break
+			}
+		}
+
+		if err := ask(ctx, r.Questions, &item, itemOptions); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// askRepeat asks the group of questions until the list passes the validators of the question.
+// It returns both the list and the list after the question's Transformer was applied.
+func askRepeat(ctx context.Context, q *Question, r *Repeat, options *AskOptions, answers Answers) (interface{}, interface{}, error) {
+	// validation errors are shown on the stdio of the options
+	r.WithStdio(options.Stdio)
+
+	for {
+		items, err := r.ask(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if validationErr := validateAnswer(q, options, answers, items); validationErr != nil {
+			// show the error and start the list over
+			if err := r.Error(&options.PromptConfig, validationErr); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		return items, transformAnswer(q, items), nil
+	}
+}
+
+// answerFromSource answers the group of questions once for every item the source has for the
+// question, so every item goes through the validators and transformers of the questions.
+func (r *Repeat) answerFromSource(ctx context.Context, value interface{}, options *AskOptions) ([]map[string]interface{}, error) {
+	sourced, err := repeatAnswer(value)
+	if err != nil {
+		return nil, err
+	}
+
+	nested := *options
+	nested.Review = false
+
+	items := []map[string]interface{}{}
+	for i, answers := range sourced {
+		nested.AnswerSource = MapSource(answers)
+
+		item := map[string]interface{}{}
+		if err := ask(ctx, r.Questions, &item, &nested); err != nil {
+			return nil, fmt.Errorf("item %d: %v", i+1, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// repeatAnswer converts a list read from an AnswerSource into the answer of a Repeat
+func repeatAnswer(value interface{}) ([]map[string]interface{}, error) {
+	switch v := value.(type) {
+	case []map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		items := []map[string]interface{}{}
+		for _, item := range v {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("cannot use %T as an item of a repeat", item)
+			}
+			items = append(items, m)
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("cannot use %T as the answer to a repeat", value)
+}
+
+// parseAnswer answers the questions of the group for every item without the options given to
+// Ask, which answers the items with its own options instead.
+func (r *Repeat) parseAnswer(value interface{}) (interface{}, error) {
+	return r.answerFromSource(context.Background(), value, defaultAskOptions())
+}
+
+// defaultAnswer returns an empty list since the source has no items for the group.
+func (r *Repeat) defaultAnswer() (interface{}, error) {
+	return []map[string]interface{}{}, nil
+}
@@ -0,0 +1,195 @@
+package survey
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+)
+
+type portMapping struct {
+	Host      int
+	Container int
+}
+
+func portQuestions() []*Question {
+	return []*Question{
+		{Name: "host", Prompt: &Input{Message: "Host port:"}},
+		{Name: "container", Prompt: &Input{Message: "Container port:"}},
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	ports := []portMapping{}
+
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("Forward a port?")
+		c.SendLine("y")
+		c.ExpectString("Host port:")
+		c.SendLine("8080")
+		c.ExpectString("Container port:")
+		c.SendLine("80")
+		c.ExpectString("Forward a port?")
+		c.SendLine("y")
+		c.ExpectString("Host port:")
+		c.SendLine("8443")
+		c.ExpectString("Container port:")
+		c.SendLine("443")
+		c.ExpectString("Forward a port?")
+		c.SendLine("n")
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		return AskOne(&Repeat{
+			Questions: portQuestions(),
+			Another:   &Confirm{Message: "Forward a port?"},
+		}, &ports, WithStdio(stdio.In, stdio.Out, stdio.Err))
+	})
+
+	assert.Equal(t, []portMapping{{8080, 80}, {8443, 443}}, ports)
+}
+
+func TestRepeat_GoBack(t *testing.T) {
+	back := "\x07" // Ctrl+G
+	ports := []portMapping{}
+
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("Forward a port?")
+		c.SendLine("y")
+		c.ExpectString("Host port:")
+		c.SendLine("8080")
+		c.ExpectString("Container port:")
+		c.SendLine("80")
+		c.ExpectString("Forward a port?")
+		c.SendLine("y")
+		c.ExpectString("Host port:")
+		c.SendLine("8443")
+		c.ExpectString("Container port:")
+		c.SendLine("443")
+		c.ExpectString("Forward a port?")
+		c.Send(back)
+		// the last item is asked again, keeping the ones before it
+		c.ExpectString("Host port: (8443)")
+		c.SendLine("")
+		c.ExpectString("Container port: (443)")
+		c.SendLine("4443")
+		c.ExpectString("Forward a port?")
+		c.SendLine("n")
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		return AskOne(&Repeat{
+			Questions: portQuestions(),
+			Another:   &Confirm{Message: "Forward a port?"},
+		}, &ports, WithStdio(stdio.In, stdio.Out, stdio.Err), WithBackKey('\x07'))
+	})
+
+	assert.Equal(t, []portMapping{{8080, 80}, {8443, 4443}}, ports)
+}
+
+func TestRepeat_Count(t *testing.T) {
+	res := struct {
+		Ports []map[string]interface{}
+	}{}
+
+	err := Ask([]*Question{
+		{
+			Name: "ports",
+			Prompt: &Repeat{
+				Questions: []*Question{
+					{Name: "host", Prompt: &mockPrompt{answers: []string{"8080", "8443"}}},
+				},
+				Count: 2,
+			},
+		},
+	}, &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{{"host": "8080"}, {"host": "8443"}}, res.Ports)
+}
+
+func TestRepeat_Validate(t *testing.T) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+
+	res := []map[string]interface{}{}
+	err = Ask([]*Question{
+		{
+			Name: "ports",
+			Prompt: &Repeat{
+				Questions: []*Question{
+					{Name: "host", Prompt: &mockPrompt{answers: []string{"8080", "8080", "8080", "8443"}}},
+				},
+				Count: 2,
+			},
+			Validate: func(ans interface{}) error {
+				items := ans.([]map[string]interface{})
+				if items[0]["host"] == items[1]["host"] {
+					return errors.New("ports must be different")
+				}
+				return nil
+			},
+		},
+	}, &res, WithStdio(nil, devNull, devNull))
+	assert.NoError(t, err)
+
+	// the whole list is asked again after a validation error
+	assert.Equal(t, []map[string]interface{}{{"host": "8080"}, {"host": "8443"}}, res)
+}
+
+func TestRepeat_AnswerSource(t *testing.T) {
+	res := struct {
+		Ports []portMapping
+		Names []map[string]interface{}
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "ports", Prompt: &Repeat{Questions: portQuestions()}},
+		{Name: "names", Prompt: &Repeat{Questions: portQuestions()}},
+	}, &res, WithAnswerSource(MapSource{
+		"ports": []interface{}{
+			map[string]interface{}{"host": "8080", "container": "80"},
+		},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []portMapping{{8080, 80}}, res.Ports)
+	assert.Empty(t, res.Names)
+}
+
+func TestRepeat_AnswerSourceRunsTheQuestions(t *testing.T) {
+	questions := func() []*Question {
+		return []*Question{
+			{Name: "name", Prompt: &Input{Message: "Name:"}, Validate: Required, Transform: ToLower},
+			{Name: "tag", Prompt: &Input{Message: "Tag:", Default: "latest"}},
+		}
+	}
+
+	res := []map[string]interface{}{}
+	err := Ask([]*Question{
+		{Name: "images", Prompt: &Repeat{Questions: questions()}},
+	}, &res, WithAnswerSource(MapSource{
+		"images": []interface{}{
+			map[string]interface{}{"name": "Nginx"},
+			map[string]interface{}{"name": "redis", "tag": "7"},
+		},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "nginx", "tag": "latest"},
+		{"name": "redis", "tag": "7"},
+	}, res)
+
+	err = Ask([]*Question{
+		{Name: "images", Prompt: &Repeat{Questions: questions()}},
+	}, &res, WithAnswerSource(MapSource{
+		"images": []interface{}{
+			map[string]interface{}{"name": "nginx"},
+			map[string]interface{}{"tag": "7"},
+		},
+	}))
+	assert.EqualError(t, err, `invalid answer for "images": item 2: missing answers for: name`)
+}
@@ -0,0 +1,236 @@
+package survey
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// ReviewMessage is the message of the summary shown by WithReview
+var ReviewMessage = "Review your answers:"
+
+// ReviewDone is the entry of the summary that finishes the review
+var ReviewDone = "Done"
+
+// review shows the answers to the questions that were asked in a Select until the user picks
+// ReviewDone. Picking an answer asks its question again with the old answer as the default.
+func review(ctx context.Context, qs []*Question, history []int, answers Answers, given []interface{}, response interface{}, options *AskOptions) error {
+	// the entry the cursor starts on
+	selected := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		entries := []string{}
+		for _, i := range history {
+			q := qs[i]
+			entries = append(entries, fmt.Sprintf("%s %s", promptMessage(q), formatAnswer(q.Prompt, answers[q.Name], &options.PromptConfig)))
+		}
+		entries = append(entries, ReviewDone)
+
+		summary := &Select{Message: ReviewMessage, Options: entries, Default: selected}
+		summary.WithStdio(options.Stdio)
+
+		choice, err := summary.Prompt(&options.PromptConfig)
+		if err == ErrGoBack {
+			// there is nothing to go back to
+			clearPrompt(summary)
+			continue
+		}
+		if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+			clearPrompt(summary)
+			return ctxErr
+		}
+		if err != nil {
+			return err
+		}
+
+		selected = choice.(core.OptionAnswer).Index
+		if selected == len(history) {
+			return summary.Cleanup(&options.PromptConfig, choice)
+		}
+		clearPrompt(summary)
+
+		// ask the question again starting from the old answer
+		i := history[selected]
+		q := qs[i]
+		if d, ok := q.Prompt.(DefaultSetter); ok {
+			_ = d.SetDefault(given[i])
+		}
+
+		others := answers.copy()
+		delete(others, q.Name)
+
+		raw, ans, err := askPrompt(ctx, q, options, others)
+		if err == ErrGoBack {
+			// keep the old answer
+			clearPrompt(q.Prompt)
+			continue
+		}
+		if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+			clearPrompt(q.Prompt)
+			return ctxErr
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := writeAnswer(response, q.Name, ans, true); err != nil {
+			return err
+		}
+		answers[q.Name] = ans
+		given[i] = raw
+
+		// the questions after it might depend on the new answer
+		history, err = revisit(ctx, qs, i, history, answers, given, response, options)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// revisit goes over the questions after the one that was edited during the review. The questions
+// whose When no longer holds lose their answer, the ones that now apply are asked and the answers
+// that no longer pass their CrossValidate are asked again. It returns the questions that were
+// answered, in order.
+func revisit(ctx context.Context, qs []*Question, edited int, history []int, answers Answers, given []interface{}, response interface{}, options *AskOptions) ([]int, error) {
+	asked := map[int]bool{}
+	kept := []int{}
+	for _, i := range history {
+		asked[i] = true
+		if i <= edited {
+			kept = append(kept, i)
+		}
+	}
+
+	for j := edited + 1; j < len(qs); j++ {
+		q := qs[j]
+
+		// the question doesn't apply anymore
+		if q.When != nil && !q.When(answers.copy()) {
+			if asked[j] {
+				if err := core.ResetAnswer(response, q.Name); err != nil {
+					return nil, err
+				}
+				delete(answers, q.Name)
+				given[j] = nil
+			}
+			if q.SkipDefault != nil {
+				if err := writeAnswer(response, q.Name, q.SkipDefault, true); err != nil {
+					return nil, err
+				}
+				answers[q.Name] = q.SkipDefault
+			}
+			continue
+		}
+
+		others := answers.copy()
+		delete(others, q.Name)
+
+		if asked[j] {
+			// keep the answers that still hold
+			if q.CrossValidate == nil || q.CrossValidate(answers[q.Name], others) == nil {
+				kept = append(kept, j)
+				continue
+			}
+			if d, ok := q.Prompt.(DefaultSetter); ok {
+				_ = d.SetDefault(given[j])
+			}
+		}
+
+		raw, ans, err := askPrompt(ctx, q, options, others)
+		if err == ErrGoBack {
+			// the question has to be answered before the review goes on
+			clearPrompt(q.Prompt)
+			j--
+			continue
+		}
+		if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+			clearPrompt(q.Prompt)
+			return nil, ctxErr
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writeAnswer(response, q.Name, ans, options.DefaultsFromResponse || asked[j]); err != nil {
+			return nil, err
+		}
+		answers[q.Name] = ans
+		given[j] = raw
+		kept = append(kept, j)
+	}
+
+	return kept, nil
+}
+
+// promptMessage returns the Message of the prompt of a question, or the name of the
+// question if the prompt doesn't have one.
+func promptMessage(q *Question) string {
+	v := reflect.Indirect(reflect.ValueOf(q.Prompt))
+	if v.Kind() == reflect.Struct {
+		if message := v.FieldByName("Message"); message.IsValid() && message.Kind() == reflect.String && message.String() != "" {
+			return message.String()
+		}
+	}
+	return q.Name
+}
+
+// optionLabeler is implemented by the prompts that show their options with labels
+type optionLabeler interface {
+	// optionLabel returns how the option of the answer was shown to the user
+	optionLabel(ans core.OptionAnswer) string
+}
+
+// answerLabel returns how the option of an answer was shown by its prompt
+func answerLabel(p Prompt, ans core.OptionAnswer) string {
+	if l, ok := p.(optionLabeler); ok {
+		return l.optionLabel(ans)
+	}
+	return ans.Value
+}
+
+// formatAnswer returns an answer the way it is shown in the summary
+func formatAnswer(p Prompt, ans interface{}, config *PromptConfig) string {
+	switch v := ans.(type) {
+	case string:
+		if _, ok := p.(*Password); ok {
+			return strings.Repeat(string(config.HideCharacter), len([]rune(v)))
+		}
+		// keep every answer on a single line
+		return strings.Replace(v, "\n", " ", -1)
+	case bool:
+		return yesNo(v)
+	case core.OptionAnswer:
+		return answerLabel(p, v)
+	case []string:
+		return strings.Join(v, ", ")
+	case []core.OptionAnswer:
+		values := []string{}
+		for _, opt := range v {
+			values = append(values, answerLabel(p, opt))
+		}
+		return strings.Join(values, ", ")
+	case []core.TreeAnswer:
+		paths := []string{}
+		for _, node := range v {
+			paths = append(paths, node.String())
+		}
+		return strings.Join(paths, ", ")
+	case map[string]string:
+		return formatKeyValues(v)
+	case []map[string]interface{}:
+		return fmt.Sprintf("%d entries", len(v))
+	case time.Time:
+		if d, ok := p.(*DateTime); ok {
+			return v.Format(d.layout())
+		}
+	}
+	return fmt.Sprint(ans)
+}
@@ -0,0 +1,164 @@
+package survey
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsk_Review(t *testing.T) {
+	answers := struct {
+		Name  string
+		Color string
+	}{}
+
+	// answer both questions, fix the name and finish
+	output := runPlainTest(t, "Johnny\n2\n1\nAl\nLarry\n3\n", func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{
+				Name:      "name",
+				Prompt:    &Input{Message: "What is your name?"},
+				Validate:  MinLength(3),
+				Transform: ToLower,
+			},
+			{
+				Name:   "color",
+				Prompt: &Select{Message: "Choose a color:", Options: []string{"red", "blue"}},
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err), WithReview())
+	})
+
+	assert.Equal(t, "larry", answers.Name)
+	assert.Equal(t, "blue", answers.Color)
+
+	assert.Contains(t, output, "? Review your answers:\n  1) What is your name? johnny\n  2) Choose a color: blue\n  3) Done\n")
+	assert.Contains(t, output, "? What is your name? (Johnny) \n")
+	assert.Contains(t, output, "value is too short")
+	assert.Contains(t, output, "? Review your answers:\n  1) What is your name? larry\n  2) Choose a color: blue\n  3) Done\n  Enter a number or value (What is your name? larry) \n")
+}
+
+func TestAsk_ReviewRevisitsLaterQuestions(t *testing.T) {
+	answers := struct {
+		Database string
+		Password string
+		File     string
+	}{}
+
+	// pick postgres, switch to sqlite during the review and answer the question that now applies
+	output := runPlainTest(t, "1\nsecret\n1\n2\ndata.db\n3\n", func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{
+				Name:   "database",
+				Prompt: &Select{Message: "Choose a database:", Options: []string{"postgres", "sqlite"}},
+			},
+			{
+				Name:   "password",
+				Prompt: &Password{Message: "Password:"},
+				When: func(answers Answers) bool {
+					return answers["database"].(core.OptionAnswer).Value == "postgres"
+				},
+			},
+			{
+				Name:   "file",
+				Prompt: &Input{Message: "File:"},
+				When: func(answers Answers) bool {
+					return answers["database"].(core.OptionAnswer).Value == "sqlite"
+				},
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err), WithReview())
+	})
+
+	assert.Equal(t, "sqlite", answers.Database)
+	assert.Equal(t, "", answers.Password)
+	assert.Equal(t, "data.db", answers.File)
+
+	assert.Contains(t, output, "? Review your answers:\n  1) Choose a database: sqlite\n  2) File: data.db\n  3) Done\n")
+}
+
+func TestAsk_ReviewCrossValidatesLaterAnswers(t *testing.T) {
+	answers := map[string]interface{}{}
+
+	// raise the minimum above the maximum during the review, which asks for the maximum again
+	output := runPlainTest(t, "1\n5\n1\n10\n20\n3\n", func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{Name: "min", Prompt: &Input{Message: "Minimum:"}},
+			{
+				Name:   "max",
+				Prompt: &Input{Message: "Maximum:"},
+				CrossValidate: func(ans interface{}, answers Answers) error {
+					max, _ := strconv.Atoi(ans.(string))
+					min, _ := strconv.Atoi(answers["min"].(string))
+					if max < min {
+						return errors.New("the maximum is below the minimum")
+					}
+					return nil
+				},
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err), WithReview())
+	})
+
+	assert.Equal(t, map[string]interface{}{"min": "10", "max": "20"}, answers)
+	assert.Contains(t, output, "? Maximum: (5) \n")
+}
+
+func TestFormatAnswer(t *testing.T) {
+	config := defaultPromptConfig()
+
+	tests := []struct {
+		prompt   Prompt
+		answer   interface{}
+		expected string
+	}{
+		{&Input{}, "first\nsecond", "first second"},
+		{&Password{}, "secret", "******"},
+		{&Confirm{}, true, "Yes"},
+		{&Select{}, core.OptionAnswer{Value: "blue", Index: 1}, "blue"},
+		{&MultiSelect{}, []core.OptionAnswer{{Value: "red"}, {Value: "blue", Index: 1}}, "red, blue"},
+		{&Select{Choices: environmentChoices()}, core.OptionAnswer{Value: "staging", Index: 1}, "Staging"},
+		{&Select{Choices: environmentChoices(), Other: "Other"}, core.OptionAnswer{Value: "qa", Index: core.OtherIndex}, "qa"},
+		{&MultiSelect{Choices: environmentChoices()}, []core.OptionAnswer{{Value: "dev"}, {Value: "demo", Index: 3}}, "Development, Demo"},
+		{&Repeat{}, []map[string]interface{}{{}, {}}, "2 entries"},
+		{&mockPrompt{}, 42, "42"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, formatAnswer(test.prompt, test.answer, config))
+	}
+}
+
+func TestPromptMessage(t *testing.T) {
+	assert.Equal(t, "What is your name?", promptMessage(&Question{Name: "name", Prompt: &Input{Message: "What is your name?"}}))
+	assert.Equal(t, "name", promptMessage(&Question{Name: "name", Prompt: &Input{}}))
+	assert.Equal(t, "name", promptMessage(&Question{Name: "name", Prompt: &mockPrompt{}}))
+}
+
+func TestAsk_ReviewInteractive(t *testing.T) {
+	answers := map[string]interface{}{}
+
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("What is your name?")
+		c.SendLine("Johnny")
+		c.ExpectString("Do you like pie?")
+		c.SendLine("n")
+		c.ExpectString("Review your answers:")
+		c.Send(string(terminal.KeyArrowDown))
+		c.SendLine("")
+		c.ExpectString("Do you like pie? (y/N)")
+		c.SendLine("y")
+		c.ExpectString("Do you like pie? Yes")
+		c.Send(string(terminal.KeyArrowDown))
+		c.SendLine("")
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{Name: "name", Prompt: &Input{Message: "What is your name?"}},
+			{Name: "pie", Prompt: &Confirm{Message: "Do you like pie?"}},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err), WithReview())
+	})
+
+	assert.Equal(t, map[string]interface{}{"name": "Johnny", "pie": true}, answers)
+}
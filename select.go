@@ -1,8 +1,10 @@
 package survey
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -18,11 +20,31 @@ for them to select using the arrow keys and enter. Response type is a string.
 		Options: []string{"red", "blue", "green"},
 	}
 	survey.AskOne(prompt, &color)
+
+LoadOptions replaces the options with the ones it returns for the text the user typed to filter
+them. It is called in the background when the prompt starts and again once the user stops typing,
+with a context that is cancelled when its results aren't needed anymore. The results are filtered
+like any other options, and a line tells the user while they are being loaded. The Default is
+looked for among the first options that are loaded, and enter does nothing until they are. Options
+and Choices are left as they are, so a prompt that is asked again starts from them.
+
+Other adds an entry with that text after the options that lets the user type their own answer,
+which ValidateOther can check. The answer is then a core.OptionAnswer with the typed value and
+core.OtherIndex as its Index.
+
+Choices can be given instead of Options to show labels instead of the values, to group the
+options under headers or to show options that can't be chosen. The cursor skips the headers and
+the disabled options, and the Index of the answer is the position of the option in Choices. The
+filter is given the label of the options.
+
+When the prompt is asked with WithHotkeys, the options are shown with their Key or a number and
+pressing it chooses the option right away, as long as the user hasn't started to filter.
 */
 type Select struct {
 	Renderer
 	Message       string
 	Options       []string
+	Choices       []Option
 	Default       interface{}
 	Help          string
 	PageSize      int
@@ -30,9 +52,23 @@ type Select struct {
 	FilterMessage string
 	Filter        func(filter string, value string, index int) bool
 	Description   func(value string, index int) string
+	LoadOptions   func(ctx context.Context, filter string) ([]string, error)
+	Other         string
+	ValidateOther Validator
+	// the options being shown, which are the ones that were loaded once LoadOptions returns
+	options       []string
+	choices       []Option
 	filter        string
 	selectedIndex int
 	showingHelp   bool
+	loader        *optionLoader
+	typingOther   bool
+	otherAnswer   string
+	otherErr      error
+	// the user's own answer the prompt starts with, given by SetDefault
+	defaultOther string
+	// the default is looked for again once the options are loaded
+	pendingDefault bool
 }
 
 // SelectTemplateData is the data available to the templates when processing
@@ -44,6 +80,12 @@ type SelectTemplateData struct {
 	ShowAnswer    bool
 	ShowHelp      bool
 	Description   func(value string, index int) string
+	Loading       bool
+	LoadError     error
+	TypingOther   bool
+	OtherAnswer   string
+	OtherError    error
+	Hotkeys       map[int]rune
 	Config        *PromptConfig
 
 	// These fields are used when rendering an individual option
@@ -66,19 +108,59 @@ func (s SelectTemplateData) GetDescription(opt core.OptionAnswer) string {
 	return s.Description(opt.Value, opt.Index)
 }
 
+// GetLabel returns how an option is shown
+func (s SelectTemplateData) GetLabel(opt core.OptionAnswer) string {
+	return choiceLabel(s.choices, opt)
+}
+
+// IsGroup returns whether the entry is the header of a group
+func (s SelectTemplateData) IsGroup(opt core.OptionAnswer) bool {
+	return opt.Index == groupIndex
+}
+
+// IsDisabled returns whether the option can't be chosen
+func (s SelectTemplateData) IsDisabled(opt core.OptionAnswer) bool {
+	return choiceAt(s.choices, opt.Index).Disabled
+}
+
+// GetDisabledReason returns why the option can't be chosen
+func (s SelectTemplateData) GetDisabledReason(opt core.OptionAnswer) string {
+	return choiceAt(s.choices, opt.Index).DisabledReason
+}
+
+// GetHotkey returns the key that chooses the option, if it has one
+func (s SelectTemplateData) GetHotkey(opt core.OptionAnswer) string {
+	if key, ok := s.Hotkeys[opt.Index]; ok && opt.Index >= 0 {
+		return string(key)
+	}
+	return ""
+}
+
 var SelectQuestionTemplate = `
 {{- define "option"}}
+  {{- if .IsGroup .CurrentOpt }}{{color "default+hb"}}{{ .CurrentOpt.Value }}{{color "reset"}}
+  {{- else}}
     {{- if eq .SelectedIndex .CurrentIndex }}{{color .Config.Icons.SelectFocus.Format }}{{ .Config.Icons.SelectFocus.Text }} {{else}}{{color "default"}}  {{end}}
-    {{- .CurrentOpt.Value}}{{ if ne ($.GetDescription .CurrentOpt) "" }} - {{color "cyan"}}{{ $.GetDescription .CurrentOpt }}{{end}}
+    {{- with .GetHotkey .CurrentOpt }}{{ . }}) {{end}}
+    {{- if .IsDisabled .CurrentOpt }}{{color "black+h"}}{{ .GetLabel .CurrentOpt }}{{ if .GetDisabledReason .CurrentOpt }} ({{ .GetDisabledReason .CurrentOpt }}){{end}}
+    {{- else}}{{ .GetLabel .CurrentOpt }}{{ if ne ($.GetDescription .CurrentOpt) "" }} - {{color "cyan"}}{{ $.GetDescription .CurrentOpt }}{{end}}{{end}}
     {{- color "reset"}}
+  {{- end}}
 {{end}}
 {{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
 {{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
 {{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
 {{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else if .TypingOther}}
+  {{- "  "}}{{- color "cyan"}}[Type your answer, enter to submit, esc to go back to the options]{{color "reset"}}
+  {{- "\n"}}
+  {{- if .OtherError}}{{color .Config.Icons.Error.Format }}{{ .Config.Icons.Error.Text }} {{ .OtherError }}{{color "reset"}}{{"\n"}}{{end}}
+  {{- color .Config.Icons.SelectFocus.Format }}{{ .Config.Icons.SelectFocus.Text }} {{color "reset"}}{{ .Other }} {{color "cyan+b"}}{{ .OtherAnswer }}_{{color "reset"}}{{"\n"}}
 {{- else}}
-  {{- "  "}}{{- color "cyan"}}[Use arrows to move, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move,{{- if .Config.Hotkeys }} press a key to choose,{{end}} type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
   {{- "\n"}}
+  {{- if .Loading}}{{"  "}}{{color "cyan"}}Loading options...{{color "reset"}}{{"\n"}}{{end}}
+  {{- if .LoadError}}{{color .Config.Icons.Error.Format }}{{ .Config.Icons.Error.Text }} Unable to load the options: {{ .LoadError }}{{color "reset"}}{{"\n"}}{{end}}
   {{- range $ix, $option := .PageEntries}}
     {{- template "option" $.IterateOption $ix $option}}
   {{- end}}
@@ -86,13 +168,27 @@ var SelectQuestionTemplate = `
 
 // OnChange is called on every keypress.
 func (s *Select) OnChange(key rune, config *PromptConfig) bool {
+	if s.typingOther {
+		return s.onOtherKey(key, config)
+	}
+
 	options := s.filterOptions(config)
 	oldFilter := s.filter
 
 	// if the user pressed the enter key and the index is a valid option
 	if key == terminal.KeyEnter || key == '\n' {
+		// the option the cursor is on isn't the default yet
+		if s.pendingDefault {
+			return false
+		}
 		// if the selected index is a valid option
-		if len(options) > 0 && s.selectedIndex < len(options) {
+		if len(options) > 0 && s.selectedIndex < len(options) && canChoose(s.choices, options[s.selectedIndex]) {
+			// the user wants to type their own answer
+			if options[s.selectedIndex].Index == core.OtherIndex {
+				s.typingOther = true
+				_ = s.render(config)
+				return false
+			}
 
 			// we're done (stop prompting the user)
 			return true
@@ -111,6 +207,8 @@ func (s *Select) OnChange(key rune, config *PromptConfig) bool {
 			// otherwise we are not at the top of the list so decrement the selected index
 			s.selectedIndex--
 		}
+		// skip the headers and the options that can't be chosen
+		s.selectedIndex = nextChoice(s.choices, options, s.selectedIndex, -1)
 
 		// if the user pressed down or 'j' to emulate vim
 	} else if (key == terminal.KeyTab || key == terminal.KeyArrowDown || (s.VimMode && key == 'j')) && len(options) > 0 {
@@ -122,6 +220,20 @@ func (s *Select) OnChange(key rune, config *PromptConfig) bool {
 			// increment the selected index
 			s.selectedIndex++
 		}
+		s.selectedIndex = nextChoice(s.choices, options, s.selectedIndex, 1)
+		// if the user wants to move a page at a time
+	} else if (key == terminal.SpecialKeyPageUp || key == terminal.SpecialKeyPageDown) && len(options) > 0 {
+		delta := s.pageSize(config)
+		if key == terminal.SpecialKeyPageUp {
+			delta = -delta
+		}
+		// pages stop at the ends of the list instead of going around
+		s.selectedIndex = moveBy(s.choices, options, s.selectedIndex, delta)
+		// if the user wants to go to the first or the last option
+	} else if key == terminal.SpecialKeyHome && len(options) > 0 {
+		s.selectedIndex = moveBy(s.choices, options, s.selectedIndex, -len(options))
+	} else if key == terminal.SpecialKeyEnd && len(options) > 0 {
+		s.selectedIndex = moveBy(s.choices, options, s.selectedIndex, len(options))
 		// only show the help message if we have one
 	} else if string(key) == config.HelpInput && s.Help != "" {
 		s.showingHelp = true
@@ -140,7 +252,11 @@ func (s *Select) OnChange(key rune, config *PromptConfig) bool {
 			s.filter = string(runeFilter[0 : len(runeFilter)-1])
 			// we removed the last value in the filter
 		}
-	} else if key >= terminal.KeySpace {
+	} else if index, ok := s.hotkey(key, config); ok {
+		// the user chose an option with its key
+		s.selectedIndex = index
+		return true
+	} else if terminal.IsTyped(key) {
 		s.filter += string(key)
 		// make sure vim mode is disabled
 		s.VimMode = false
@@ -156,35 +272,144 @@ func (s *Select) OnChange(key rune, config *PromptConfig) bool {
 		if len(options) > 0 && len(options) <= s.selectedIndex {
 			s.selectedIndex = len(options) - 1
 		}
+		s.selectedIndex = nextChoice(s.choices, options, s.selectedIndex, 1)
+		// ask for the options that go with the new filter
+		s.loader.refresh(s.filter)
+	}
+
+	// render the options
+	_ = s.render(config)
+
+	// keep prompting
+	return false
+}
+
+// onOtherKey handles the keys pressed while the user types their own answer
+func (s *Select) onOtherKey(key rune, config *PromptConfig) bool {
+	if key == terminal.KeyEnter || key == '\n' {
+		s.otherErr = checkOther(s.otherAnswer, s.ValidateOther)
+		if s.otherErr == nil {
+			return true
+		}
+	} else if key == terminal.KeyEscape {
+		// go back to the options
+		s.typingOther = false
+		s.otherErr = nil
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		s.otherAnswer = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if s.otherAnswer != "" {
+			runeAnswer := []rune(s.otherAnswer)
+			s.otherAnswer = string(runeAnswer[0 : len(runeAnswer)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		s.otherAnswer += string(key)
 	}
 
-	// figure out the options and index to render
-	// figure out the page size
-	pageSize := s.PageSize
+	_ = s.render(config)
+	return false
+}
+
+// hotkey returns where the option chosen by the key is listed, if the key chooses one. Keys only
+// choose options until the user starts to filter them.
+func (s *Select) hotkey(key rune, config *PromptConfig) (int, bool) {
+	if !config.Hotkeys || s.filter != "" {
+		return 0, false
+	}
+	return hotkeyEntry(s.choices, s.filterOptions(config), hotkeys(s.choices, s.options), key)
+}
+
+// pageSize returns how many options are shown at a time
+func (s *Select) pageSize(config *PromptConfig) int {
 	// if we dont have a specific one
-	if pageSize == 0 {
+	if s.PageSize == 0 {
 		// grab the global value
-		pageSize = config.PageSize
+		return config.PageSize
 	}
+	return s.PageSize
+}
 
+// render shows the page of the options with the selected one
+func (s *Select) render(config *PromptConfig) error {
 	// TODO if we have started filtering and were looking at the end of a list
 	// and we have modified the filter then we should move the page back!
-	opts, idx := paginate(pageSize, options, s.selectedIndex)
+	opts, idx := paginate(s.pageSize(config), s.filterOptions(config), s.selectedIndex)
+	if s.typingOther {
+		// the options are hidden while the user types
+		opts, idx = nil, 0
+	}
 
+	loading, loadErr := s.loader.status()
 	tmplData := SelectTemplateData{
 		Select:        *s,
 		SelectedIndex: idx,
 		ShowHelp:      s.showingHelp,
 		Description:   s.Description,
 		PageEntries:   opts,
+		Loading:       loading,
+		LoadError:     loadErr,
+		TypingOther:   s.typingOther,
+		OtherAnswer:   s.otherAnswer,
+		OtherError:    s.otherErr,
 		Config:        config,
 	}
+	if config.Hotkeys {
+		tmplData.Hotkeys = hotkeys(s.choices, s.options)
+	}
 
-	// render the options
-	_ = s.RenderWithCursorOffset(SelectQuestionTemplate, tmplData, opts, idx)
+	return s.RenderWithCursorOffset(SelectQuestionTemplate, tmplData, opts, idx)
+}
 
-	// keep prompting
-	return false
+// loaded replaces the options with the ones that were loaded, keeping the same option selected
+func (s *Select) loaded(config *PromptConfig, options []string, err error) {
+	if err == nil {
+		selected := ""
+		if current := s.filterOptions(config); s.selectedIndex < len(current) {
+			selected = current[s.selectedIndex].Value
+		}
+
+		s.options = options
+		// the loaded options have nothing more to them than their values
+		s.choices = nil
+		s.selectedIndex = 0
+		for i, opt := range s.filterOptions(config) {
+			if opt.Value == selected {
+				s.selectedIndex = i
+				break
+			}
+		}
+	}
+
+	// the default can only be found among the options that were loaded
+	if s.pendingDefault {
+		s.pendingDefault = false
+		if index, err := s.defaultIndex(); err == nil {
+			s.selectedIndex = entryOf(s.choices, s.filterOptions(config), index)
+		}
+	}
+
+	_ = s.render(config)
+}
+
+// start shows the options the prompt was given, until the loaded ones replace them
+func (s *Select) start() {
+	s.options = optionValues(s.Choices, s.Options)
+	s.choices = s.Choices
+}
+
+// fetchOptions loads the options right away for when the prompt can't show them as they load
+func (s *Select) fetchOptions() error {
+	s.start()
+	if s.LoadOptions == nil {
+		return nil
+	}
+	options, err := s.LoadOptions(context.Background(), "")
+	if err != nil {
+		return fmt.Errorf("unable to load the options: %w", err)
+	}
+	s.options = options
+	s.choices = nil
+	return nil
 }
 
 func (s *Select) filterOptions(config *PromptConfig) []core.OptionAnswer {
@@ -193,7 +418,7 @@ func (s *Select) filterOptions(config *PromptConfig) []core.OptionAnswer {
 
 	// if there is no filter applied
 	if s.filter == "" {
-		return core.OptionAnswerList(s.Options)
+		return withOther(withGroups(s.choices, core.OptionAnswerList(s.options)), s.Other)
 	}
 
 	// the filter to apply
@@ -202,9 +427,9 @@ func (s *Select) filterOptions(config *PromptConfig) []core.OptionAnswer {
 		filter = config.Filter
 	}
 
-	for i, opt := range s.Options {
+	for i, opt := range s.options {
 		// i the filter says to include the option
-		if filter(s.filter, opt, i) {
+		if filter(s.filter, choiceLabel(s.choices, core.OptionAnswer{Value: opt, Index: i}), i) {
 			answers = append(answers, core.OptionAnswer{
 				Index: i,
 				Value: opt,
@@ -213,50 +438,91 @@ func (s *Select) filterOptions(config *PromptConfig) []core.OptionAnswer {
 	}
 
 	// return the list of answers
-	return answers
+	return withOther(withGroups(s.choices, answers), s.Other)
+}
+
+func (s *Select) optionLabel(ans core.OptionAnswer) string {
+	// the loaded options replace the choices the answer could have come from
+	if s.LoadOptions != nil {
+		return choiceLabel(s.choices, ans)
+	}
+	return choiceLabel(s.Choices, ans)
+}
+
+// startsOnOther is true when the prompt starts with the answer the user typed themselves
+func (s *Select) startsOnOther() bool {
+	return s.Other != "" && s.defaultOther != ""
+}
+
+// defaultIndex returns the index of the option that is selected when the prompt starts.
+func (s *Select) defaultIndex() (int, error) {
+	if s.Default == nil {
+		return 0, nil
+	}
+
+	switch defaultValue := s.Default.(type) {
+	case string:
+		index := -1
+		for i, opt := range s.options {
+			if opt == defaultValue {
+				index = i
+			}
+		}
+		if index == -1 {
+			return 0, fmt.Errorf("default value %q not found in options", defaultValue)
+		}
+		return index, nil
+	case int:
+		if defaultValue < 0 || defaultValue >= len(s.options) {
+			return 0, fmt.Errorf("default index %d exceeds the number of options", defaultValue)
+		}
+		return defaultValue, nil
+	}
+	return 0, errors.New("default value of select must be an int or string")
 }
 
 func (s *Select) Prompt(config *PromptConfig) (interface{}, error) {
+	s.start()
+
+	// the options are loaded while the prompt is shown when there is a terminal
+	loading := s.LoadOptions != nil && s.interactive()
+	if !loading {
+		if err := s.fetchOptions(); err != nil {
+			return "", err
+		}
+	}
+
 	// if there are no options to render
-	if len(s.Options) == 0 {
+	if len(s.options) == 0 && !loading {
 		// we failed
 		return "", errors.New("please provide options to select from")
 	}
 
-	s.selectedIndex = 0
-	if s.Default != nil {
-		switch defaultValue := s.Default.(type) {
-		case string:
-			var found bool
-			for i, opt := range s.Options {
-				if opt == defaultValue {
-					s.selectedIndex = i
-					found = true
-				}
-			}
-			if !found {
-				return "", fmt.Errorf("default value %q not found in options", defaultValue)
-			}
-		case int:
-			if defaultValue >= len(s.Options) {
-				return "", fmt.Errorf("default index %d exceeds the number of options", defaultValue)
-			}
-			s.selectedIndex = defaultValue
-		default:
-			return "", errors.New("default value of select must be an int or string")
-		}
+	s.typingOther = false
+	s.otherAnswer = s.defaultOther
+	s.otherErr = nil
+
+	var err error
+	s.selectedIndex, err = s.defaultIndex()
+	s.pendingDefault = false
+	if err != nil && loading {
+		// the default might be one of the options that are being loaded
+		s.selectedIndex, err = 0, nil
+		s.pendingDefault = !s.startsOnOther()
 	}
-
-	// figure out the page size
-	pageSize := s.PageSize
-	// if we dont have a specific one
-	if pageSize == 0 {
-		// grab the global value
-		pageSize = config.PageSize
+	if err != nil {
+		return "", err
 	}
 
-	// figure out the options and index to render
-	opts, idx := paginate(pageSize, core.OptionAnswerList(s.Options), s.selectedIndex)
+	if !s.interactive() {
+		return s.promptPlain(config)
+	}
+	// the headers of the groups are listed along with the options
+	s.selectedIndex = entryOf(s.choices, s.filterOptions(config), s.selectedIndex)
+	if s.startsOnOther() {
+		// the Other entry comes after all of the options
+		s.selectedIndex = len(s.filterOptions(config)) - 1
+	}
 
 	cursor := s.NewCursor()
 	cursor.Save()          // for proper cursor placement during selection
@@ -264,17 +530,22 @@ func (s *Select) Prompt(config *PromptConfig) (interface{}, error) {
 	defer cursor.Show()    // show the cursor when we're done
 	defer cursor.Restore() // clear any accessibility offsetting on exit
 
-	tmplData := SelectTemplateData{
-		Select:        *s,
-		SelectedIndex: idx,
-		Description:   s.Description,
-		ShowHelp:      s.showingHelp,
-		PageEntries:   opts,
-		Config:        config,
+	s.loader = nil
+	if loading {
+		s.loader = newOptionLoader(s.LoadOptions, func(options []string, err error) {
+			s.loaded(config, options, err)
+		})
+		// drop any results that come in once we're done
+		defer s.loader.stop()
 	}
 
-	// ask the question
-	err := s.RenderWithCursorOffset(SelectQuestionTemplate, tmplData, opts, idx)
+	// ask the question while the options start to load
+	s.loader.lock()
+	if loading {
+		s.loader.request("", 0)
+	}
+	err = s.render(config)
+	s.loader.unlock()
 	if err != nil {
 		return "", err
 	}
@@ -294,33 +565,181 @@ func (s *Select) Prompt(config *PromptConfig) (interface{}, error) {
 		if r == terminal.KeyInterrupt {
 			return "", terminal.InterruptErr
 		}
+		if config.BackKey != 0 && r == config.BackKey {
+			s.loader.stop()
+			s.filter = ""
+			s.FilterMessage = ""
+			return "", ErrGoBack
+		}
 		if r == terminal.KeyEndTransmission {
 			break
 		}
-		if s.OnChange(r, config) {
+		s.loader.lock()
+		done := s.OnChange(r, config)
+		s.loader.unlock()
+		if done {
 			break
 		}
 	}
+	s.loader.stop()
 
 	options := s.filterOptions(config)
 	s.filter = ""
 	s.FilterMessage = ""
 
+	if len(options) == 0 {
+		return "", errors.New("please provide options to select from")
+	}
+	answer := options[0]
 	if s.selectedIndex < len(options) {
-		return options[s.selectedIndex], err
+		answer = options[s.selectedIndex]
 	}
 
-	return options[0], err
+	if s.typingOther || answer.Index == core.OtherIndex {
+		return typedOther(s.otherAnswer, s.ValidateOther)
+	}
+	if !canChoose(s.choices, answer) {
+		return "", errors.New("none of the options can be chosen")
+	}
+	return choiceAnswer(s.choices, answer), nil
+}
+
+// promptPlain lists the numbered options and reads a line with either the number or the value
+// of an option.
+func (s *Select) promptPlain(config *PromptConfig) (interface{}, error) {
+	instructions := "Enter a number or value"
+	if s.Other != "" {
+		instructions = "Enter a number, a value or your own answer"
+	}
+
+	dflt := choiceAnswer(s.choices, core.OptionAnswer{Value: s.options[s.selectedIndex], Index: s.selectedIndex})
+	if s.startsOnOther() {
+		dflt = core.OptionAnswer{Value: s.defaultOther, Index: core.OtherIndex}
+	}
+
+	return s.askPlain(config, PlainTemplateData{
+		Message:      s.Message,
+		Help:         s.Help,
+		Options:      plainChoices(s.choices, s.options),
+		Instructions: instructions,
+		Default:      dflt.Value,
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return dflt, nil
+		}
+
+		ans, err := parsePlainOption(s.options, line)
+		if err != nil && s.Other != "" {
+			ans, err = typedOther(line, s.ValidateOther)
+		} else if err == nil {
+			err = checkChoice(s.choices, ans)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return choiceAnswer(s.choices, ans), nil
+	})
+}
+
+// parseAnswer picks the option with the value given by an AnswerSource, or takes the value as
+// the user's own answer when the prompt has an Other entry.
+func (s *Select) parseAnswer(value interface{}) (interface{}, error) {
+	if err := s.fetchOptions(); err != nil {
+		return nil, err
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot use %T as the answer to a select", value)
+	}
+
+	ans, err := findOption(s.options, str)
+	if err != nil && s.Other != "" {
+		return typedOther(str, s.ValidateOther)
+	}
+	if err == nil {
+		err = checkChoice(s.choices, ans)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return choiceAnswer(s.choices, ans), nil
+}
+
+// defaultAnswer returns the option the cursor starts on.
+func (s *Select) defaultAnswer() (interface{}, error) {
+	if err := s.fetchOptions(); err != nil {
+		return nil, err
+	}
+	if s.startsOnOther() {
+		return core.OptionAnswer{Value: s.defaultOther, Index: core.OtherIndex}, nil
+	}
+	if len(s.options) == 0 {
+		return nil, errors.New("please provide options to select from")
+	}
+	index, err := s.defaultIndex()
+	if err != nil {
+		return nil, err
+	}
+	return choiceAnswer(s.choices, core.OptionAnswer{Value: s.options[index], Index: index}), nil
+}
+
+// SetDefault uses the given answer as the default selection. It accepts an OptionAnswer,
+// the value of an option or the index of one. An OptionAnswer with core.OtherIndex starts
+// the prompt on the Other entry with the answer the user typed.
+func (s *Select) SetDefault(value interface{}) error {
+	options := optionValues(s.Choices, s.Options)
+	switch v := value.(type) {
+	case core.OptionAnswer:
+		if v.Index == core.OtherIndex {
+			if s.Other == "" {
+				return fmt.Errorf("cannot use %q as a default without an Other entry", v.Value)
+			}
+			s.defaultOther = v.Value
+			return nil
+		}
+		// the options might be loaded in another order, so look for the value once they are
+		if s.LoadOptions != nil {
+			return s.SetDefault(v.Value)
+		}
+		return s.SetDefault(v.Index)
+	case string:
+		// the options that will be loaded aren't known yet
+		if s.LoadOptions != nil {
+			s.Default = v
+			s.defaultOther = ""
+			return nil
+		}
+		for _, opt := range options {
+			if opt == v {
+				s.Default = v
+				s.defaultOther = ""
+				return nil
+			}
+		}
+		return fmt.Errorf("default value %q not found in options", v)
+	case int:
+		// the index is checked once the options are loaded
+		if s.LoadOptions == nil && (v < 0 || v >= len(options)) {
+			return fmt.Errorf("default index %d exceeds the number of options", v)
+		}
+		s.Default = v
+		s.defaultOther = ""
+		return nil
+	}
+	return fmt.Errorf("cannot use %T as the default of a select", value)
 }
 
 func (s *Select) Cleanup(config *PromptConfig, val interface{}) error {
+	if !s.interactive() {
+		return nil
+	}
 	cursor := s.NewCursor()
 	cursor.Restore()
 	return s.Render(
 		SelectQuestionTemplate,
 		SelectTemplateData{
 			Select:      *s,
-			Answer:      val.(core.OptionAnswer).Value,
+			Answer:      choiceLabel(s.choices, val.(core.OptionAnswer)),
 			ShowAnswer:  true,
 			Description: s.Description,
 			Config:      config,
@@ -2,6 +2,8 @@ package survey
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -374,3 +376,645 @@ func TestSelectPrompt(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectSetDefault(t *testing.T) {
+	prompt := &Select{
+		Options: []string{"red", "blue", "green"},
+	}
+
+	assert.NoError(t, prompt.SetDefault(core.OptionAnswer{Value: "green", Index: 2}))
+	assert.Equal(t, 2, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault("blue"))
+	assert.Equal(t, "blue", prompt.Default)
+
+	assert.Error(t, prompt.SetDefault("purple"))
+	assert.Error(t, prompt.SetDefault(3))
+	assert.Error(t, prompt.SetDefault(true))
+	assert.Equal(t, "blue", prompt.Default)
+}
+
+// branchLoader loads the branches that start with the filter
+func branchLoader(ctx context.Context, filter string) ([]string, error) {
+	branches := []string{}
+	for _, branch := range []string{"main", "develop", "feature-login", "feature-search"} {
+		if strings.HasPrefix(branch, filter) {
+			branches = append(branches, branch)
+		}
+	}
+	return branches, nil
+}
+
+func TestSelectLoadOptions(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"load the options",
+			&Select{
+				Message:     "Choose a branch:",
+				LoadOptions: branchLoader,
+			},
+			func(c expectConsole) {
+				c.ExpectString("Loading options...")
+				c.ExpectString("feature-search")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "develop", Index: 1},
+		},
+		{
+			"load the options for the filter",
+			&Select{
+				Message: "Choose a branch:",
+				LoadOptions: func(ctx context.Context, filter string) ([]string, error) {
+					if filter == "" {
+						// wait for the load to be replaced by the one for the filter
+						<-ctx.Done()
+						return []string{"stale"}, nil
+					}
+					return branchLoader(ctx, filter)
+				},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Loading options...")
+				c.Send("feature")
+				c.ExpectString("feature-search")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "feature-search", Index: 1},
+		},
+		{
+			"keep the default selected",
+			&Select{
+				Message:     "Choose a branch:",
+				LoadOptions: branchLoader,
+				Default:     "feature-login",
+			},
+			func(c expectConsole) {
+				c.ExpectString("feature-search")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "feature-login", Index: 2},
+		},
+		{
+			"find the default index among the loaded options",
+			&Select{
+				Message:     "Choose a branch:",
+				LoadOptions: branchLoader,
+				Default:     3,
+			},
+			func(c expectConsole) {
+				c.ExpectString("feature-search")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "feature-search", Index: 3},
+		},
+		func() PromptTest {
+			release := make(chan struct{})
+			return PromptTest{
+				"wait for the default to load",
+				&Select{
+					Message: "Choose a branch:",
+					Help:    "The branch to check out",
+					LoadOptions: func(ctx context.Context, filter string) ([]string, error) {
+						<-release
+						return branchLoader(ctx, filter)
+					},
+					Default: "feature-login",
+				},
+				func(c expectConsole) {
+					c.ExpectString("Loading options...")
+					// enter does nothing until the default is found
+					c.SendLine("")
+					c.Send("?")
+					c.ExpectString("The branch to check out")
+					close(release)
+					c.ExpectString("feature-search")
+					c.SendLine("")
+					c.ExpectEOF()
+				},
+				core.OptionAnswer{Value: "feature-login", Index: 2},
+			}
+		}(),
+		{
+			"failed load",
+			&Select{
+				Message: "Choose a branch:",
+				LoadOptions: func(ctx context.Context, filter string) ([]string, error) {
+					if filter == "" {
+						return nil, errors.New("no repository")
+					}
+					return branchLoader(ctx, filter)
+				},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Unable to load the options: no repository")
+				c.Send("dev")
+				c.ExpectString("develop")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "develop", Index: 0},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestSelectLoadOptionsKeepsChoices(t *testing.T) {
+	prompt := &Select{Choices: environmentChoices(), LoadOptions: branchLoader}
+
+	ans, err := prompt.parseAnswer("develop")
+	assert.NoError(t, err)
+	assert.Equal(t, core.OptionAnswer{Value: "develop", Index: 1}, ans)
+
+	// the loaded options are only shown, the ones the prompt was given stay for the next time
+	assert.Equal(t, environmentChoices(), prompt.Choices)
+	assert.Nil(t, prompt.Options)
+	prompt.start()
+	assert.Equal(t, optionValues(environmentChoices(), nil), prompt.options)
+
+	// the default is found by its value once the options are loaded again
+	assert.NoError(t, prompt.SetDefault(ans))
+	assert.Equal(t, "develop", prompt.Default)
+}
+
+func TestSelectOther(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"type an answer",
+			&Select{
+				Message: "Choose a color:",
+				Options: []string{"red", "blue", "green"},
+				Other:   "Other...",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Other...")
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectString("Type your answer")
+				c.Send("purple")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "purple", Index: core.OtherIndex},
+		},
+		{
+			"validate the answer",
+			&Select{
+				Message:       "Choose a color:",
+				Options:       []string{"red", "blue", "green"},
+				Other:         "Other...",
+				ValidateOther: MaxLength(5),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Other...")
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.Send("magenta")
+				c.SendLine("")
+				c.ExpectString("value is too long. Max length is 5")
+				c.Send(string(terminal.KeyDeleteWord))
+				c.Send("cyan")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "cyan", Index: core.OtherIndex},
+		},
+		{
+			"go back to the options",
+			&Select{
+				Message: "Choose a color:",
+				Options: []string{"red", "blue", "green"},
+				Other:   "Other...",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Other...")
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectString("Type your answer")
+				c.Send("pur")
+				c.Send(string(terminal.KeyEscape))
+				c.ExpectString("Use arrows to move")
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "green", Index: 2},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestSelectSetDefaultOther(t *testing.T) {
+	prompt := &Select{Options: []string{"red", "blue"}}
+	assert.Error(t, prompt.SetDefault(core.OptionAnswer{Value: "purple", Index: core.OtherIndex}))
+
+	prompt.Other = "Other..."
+	assert.NoError(t, prompt.SetDefault(core.OptionAnswer{Value: "purple", Index: core.OtherIndex}))
+
+	ans, err := prompt.defaultAnswer()
+	assert.NoError(t, err)
+	assert.Equal(t, core.OptionAnswer{Value: "purple", Index: core.OtherIndex}, ans)
+
+	// choosing an option again forgets the typed answer
+	assert.NoError(t, prompt.SetDefault(core.OptionAnswer{Value: "blue", Index: 1}))
+	ans, err = prompt.defaultAnswer()
+	assert.NoError(t, err)
+	assert.Equal(t, core.OptionAnswer{Value: "blue", Index: 1}, ans)
+}
+
+func TestSelect_WriteOther(t *testing.T) {
+	answers := struct {
+		Color string
+		Index int
+	}{}
+
+	prompt := &Select{Options: []string{"red", "blue"}, Other: "Other..."}
+	err := Ask([]*Question{
+		{Name: "color", Prompt: prompt},
+		{Name: "index", Prompt: prompt},
+	}, &answers, WithAnswerSource(MapSource{
+		"color": "purple",
+		"index": "teal",
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "purple", answers.Color)
+	assert.Equal(t, OtherIndex, answers.Index)
+}
+
+// environmentChoices are grouped and production can't be chosen
+func environmentChoices() []Option {
+	return []Option{
+		{Value: "dev", Label: "Development", Group: "Internal"},
+		{Value: "staging", Label: "Staging", Group: "Internal"},
+		{Value: "prod", Label: "Production", Group: "Public", Disabled: true, DisabledReason: "no permission"},
+		{Value: "demo", Label: "Demo", Group: "Public"},
+	}
+}
+
+func TestSelectChoicesRender(t *testing.T) {
+	prompt := Select{Message: "Choose an environment:", Choices: environmentChoices()}
+	prompt.start()
+
+	r, w, err := os.Pipe()
+	assert.NoError(t, err)
+	prompt.WithStdio(terminal.Stdio{Out: w})
+
+	err = prompt.Render(SelectQuestionTemplate, SelectTemplateData{
+		Select:        prompt,
+		SelectedIndex: 5,
+		PageEntries:   withGroups(prompt.Choices, core.OptionAnswerList(optionValues(prompt.Choices, nil))),
+		Config:        defaultPromptConfig(),
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.Close())
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, r)
+	assert.NoError(t, err)
+
+	assert.Contains(t, buf.String(), strings.Join(
+		[]string{
+			"Internal",
+			"  Development",
+			"  Staging",
+			"Public",
+			"  Production (no permission)",
+			fmt.Sprintf("%s Demo\n", defaultIcons().SelectFocus.Text),
+		},
+		"\n",
+	))
+}
+
+func TestSelectChoices(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"the cursor skips the headers and the disabled options",
+			&Select{
+				Message: "Choose an environment:",
+				Choices: environmentChoices(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an environment:")
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "demo", Index: 3},
+		},
+		{
+			"going up from the top",
+			&Select{
+				Message: "Choose an environment:",
+				Choices: environmentChoices(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an environment:")
+				c.Send(string(terminal.KeyArrowUp))
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "staging", Index: 1},
+		},
+		{
+			"filter keeps the headers of the matches",
+			&Select{
+				Message: "Choose an environment:",
+				Choices: environmentChoices(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an environment:")
+				// matches the labels
+				c.Send("de")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "demo", Index: 3},
+		},
+		{
+			"disabled default",
+			&Select{
+				Message: "Choose an environment:",
+				Choices: environmentChoices(),
+				Default: "prod",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an environment:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "demo", Index: 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestSelect_WriteChoice(t *testing.T) {
+	env := ""
+	prompt := &Select{Choices: environmentChoices()}
+
+	assert.NoError(t, Ask([]*Question{{Name: "env", Prompt: prompt}}, &env, WithAnswerSource(MapSource{"env": "staging"})))
+	assert.Equal(t, "staging", env)
+
+	err := Ask([]*Question{{Name: "env", Prompt: prompt}}, &env, WithAnswerSource(MapSource{"env": "prod"}))
+	assert.EqualError(t, err, `invalid answer for "env": "prod" can't be chosen: no permission`)
+}
+
+// environment is the data of the options of deployTargets
+type environment struct {
+	Name string
+	ID   int
+}
+
+func deployTargets() []Option {
+	return []Option{
+		{Value: "dev", Label: "Development", Data: environment{Name: "dev", ID: 12}},
+		{Value: "prod", Label: "Production", Data: environment{Name: "prod", ID: 7}},
+	}
+}
+
+func TestSelectData(t *testing.T) {
+	RunPromptTest(t, PromptTest{
+		"answers with the data of the option",
+		&Select{Message: "Choose an environment:", Choices: deployTargets()},
+		func(c expectConsole) {
+			c.ExpectString("Production")
+			c.Send(string(terminal.KeyArrowDown))
+			c.SendLine("")
+			c.ExpectEOF()
+		},
+		core.OptionAnswer{Value: "prod", Index: 1, Data: environment{Name: "prod", ID: 7}},
+	})
+}
+
+func TestSelect_WriteData(t *testing.T) {
+	answers := struct {
+		Env   environment
+		Value string
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "env", Prompt: &Select{Choices: deployTargets()}},
+		{Name: "value", Prompt: &Select{Choices: []Option{{Value: "dev"}, {Value: "prod"}}}},
+	}, &answers, WithAnswerSource(MapSource{
+		"env":   "prod",
+		"value": "prod",
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, environment{Name: "prod", ID: 7}, answers.Env)
+	// options without data still answer with their value
+	assert.Equal(t, "prod", answers.Value)
+
+	// the data in the response is used to find the default
+	prompt := &Select{Choices: deployTargets()}
+	err = Ask([]*Question{{Name: "env", Prompt: prompt}}, &answers, WithAnswerSource(MapSource{}), WithDefaultsFromResponse())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, prompt.Default)
+	assert.Equal(t, environment{Name: "prod", ID: 7}, answers.Env)
+}
+
+func TestSelectHotkeys(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"numbered options",
+			&Select{
+				Message: "Choose a color:",
+				Options: []string{"red", "blue", "green"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a color:  [Use arrows to move, press a key to choose, type to filter]")
+				c.ExpectString("2) blue")
+				c.Send("2")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "blue", Index: 1},
+		},
+		{
+			"keys of the options",
+			&Select{
+				Message: "Deploy now?",
+				Choices: []Option{{Value: "yes", Key: 'y'}, {Value: "no", Key: 'n'}, {Value: "later"}},
+			},
+			func(c expectConsole) {
+				c.ExpectString("1) later")
+				c.Send("n")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "no", Index: 1},
+		},
+		{
+			"disabled options have no number",
+			&Select{
+				Message: "Choose an environment:",
+				Choices: environmentChoices(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("3) Demo")
+				c.Send("3")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "demo", Index: 3},
+		},
+		{
+			"keys are filtered once the user starts to filter",
+			&Select{
+				Message: "Choose a fruit:",
+				Options: []string{"apple", "banana", "cherry"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a fruit:")
+				c.Send("ban1")
+				c.ExpectString("ban1")
+				c.Send(string(terminal.KeyBackspace))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "banana", Index: 1},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTestHotkeys(t, test)
+		})
+	}
+}
+
+// the sequences a terminal sends for the keys that move a page at a time or to the ends of a list
+const (
+	pageUpSequence   = "\x1b[5~"
+	pageDownSequence = "\x1b[6~"
+	homeSequence     = "\x1b[H"
+	endSequence      = "\x1b[F"
+)
+
+// numberedOptions returns options named after their index
+func numberedOptions(count int) []string {
+	options := []string{}
+	for i := 0; i < count; i++ {
+		options = append(options, fmt.Sprintf("option-%d", i))
+	}
+	return options
+}
+
+func TestSelectPageNavigation(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"page down and up",
+			&Select{
+				Message:  "Choose an option:",
+				Options:  numberedOptions(20),
+				PageSize: 5,
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an option:")
+				c.Send(pageDownSequence)
+				c.Send(pageDownSequence)
+				c.Send(pageUpSequence)
+				c.ExpectString("option-7")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "option-5", Index: 5},
+		},
+		{
+			"pages stop at the ends of the list",
+			&Select{
+				Message:  "Choose an option:",
+				Options:  numberedOptions(7),
+				PageSize: 5,
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an option:")
+				c.Send(pageDownSequence)
+				c.Send(pageDownSequence)
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "option-6", Index: 6},
+		},
+		{
+			"control keys don't page",
+			&Select{
+				Message:  "Choose an option:",
+				Options:  numberedOptions(20),
+				PageSize: 5,
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an option:")
+				// Ctrl+T, which used to move a page down
+				c.Send("\x14")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "option-0", Index: 0},
+		},
+		{
+			"end and home",
+			&Select{
+				Message: "Choose an option:",
+				Options: numberedOptions(20),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an option:")
+				c.Send(endSequence)
+				c.ExpectString("option-19")
+				c.Send(homeSequence)
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "option-1", Index: 1},
+		},
+		{
+			"end of the filtered list skips the disabled options",
+			&Select{
+				Message: "Choose an environment:",
+				Choices: append(environmentChoices(), Option{Value: "legacy", Disabled: true}),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an environment:")
+				c.Send("e")
+				c.Send(endSequence)
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "demo", Index: 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
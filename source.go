@@ -0,0 +1,213 @@
+package survey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// AnswerSource provides answers to questions without prompting the user, for example
+// when running in CI where there is no terminal. Lookup returns the answer for the
+// question with the given name and whether the source had one.
+type AnswerSource interface {
+	Lookup(name string) (interface{}, bool)
+}
+
+// MapSource is an AnswerSource backed by a map of question names to answers.
+type MapSource map[string]interface{}
+
+// Lookup returns the value stored under the question name.
+func (m MapSource) Lookup(name string) (interface{}, bool) {
+	value, ok := m[name]
+	return value, ok
+}
+
+// EnvSource is an AnswerSource that reads answers from environment variables. The variable
+// for a question is the source's prefix followed by the question name in upper case, with
+// every character that is not a letter or digit replaced by an underscore. For example, the
+// question "db-host" is answered by $APP_DB_HOST when using EnvSource("APP_").
+type EnvSource string
+
+// Lookup returns the value of the environment variable for the question name.
+func (prefix EnvSource) Lookup(name string) (interface{}, bool) {
+	key := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, name)
+
+	return os.LookupEnv(string(prefix) + key)
+}
+
+// JSONFileSource reads the file at path, which must contain a JSON object mapping question
+// names to answers.
+func JSONFileSource(path string) (MapSource, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	source := MapSource{}
+	if err := json.Unmarshal(contents, &source); err != nil {
+		return nil, fmt.Errorf("could not read answers from %s: %v", path, err)
+	}
+	return source, nil
+}
+
+// WithAnswerSource answers every question from the given source instead of prompting the
+// user. Answers are validated and transformed as if the user had typed them. Questions the
+// source has no answer for get the answer the prompt would give if the user just pressed
+// enter; if that answer is not valid, Ask returns a MissingAnswersError.
+func WithAnswerSource(source AnswerSource) AskOpt {
+	return func(options *AskOptions) error {
+		options.AnswerSource = source
+		return nil
+	}
+}
+
+// MissingAnswersError is returned by Ask when an AnswerSource could not answer
+// every question that needs an answer.
+type MissingAnswersError struct {
+	Names []string
+}
+
+func (e *MissingAnswersError) Error() string {
+	return fmt.Sprintf("missing answers for: %s", strings.Join(e.Names, ", "))
+}
+
+// errNoAnswer is returned when a question can't be answered without prompting
+type errNoAnswer struct {
+	err error
+}
+
+func (e errNoAnswer) Error() string {
+	return e.err.Error()
+}
+
+// answerFromSource looks up the answer to a question in the source and runs it through the
+// validators of the question. It returns both the answer and the transformed answer.
+func answerFromSource(ctx context.Context, q *Question, options *AskOptions, answers Answers) (interface{}, interface{}, error) {
+	value, found := options.AnswerSource.Lookup(q.Name)
+
+	var ans interface{}
+	var err error
+	if r, ok := q.Prompt.(*Repeat); ok && found {
+		// every item answers the questions of the group
+		ans, err = r.answerFromSource(ctx, value, options)
+	} else if found {
+		ans, err = parseAnswer(q.Prompt, value)
+	} else {
+		ans, err = defaultAnswer(q.Prompt)
+	}
+	if err != nil {
+		if !found {
+			return nil, nil, errNoAnswer{err}
+		}
+		return nil, nil, fmt.Errorf("invalid answer for %q: %v", q.Name, err)
+	}
+
+	if err := validateAnswer(q, options, answers, ans); err != nil {
+		if !found {
+			return nil, nil, errNoAnswer{err}
+		}
+		return nil, nil, fmt.Errorf("invalid answer for %q: %v", q.Name, err)
+	}
+
+	return ans, transformAnswer(q, ans), nil
+}
+
+// sourceAnswerer is implemented by the prompts that can be answered without prompting the user.
+type sourceAnswerer interface {
+	// parseAnswer converts a value provided by an AnswerSource into the answer the prompt would return
+	parseAnswer(value interface{}) (interface{}, error)
+	// defaultAnswer returns the answer the prompt would give if the user pressed enter straight away
+	defaultAnswer() (interface{}, error)
+}
+
+// parseAnswer converts a value provided by an AnswerSource into the type of answer
+// the prompt would return.
+func parseAnswer(p Prompt, value interface{}) (interface{}, error) {
+	if a, ok := p.(sourceAnswerer); ok {
+		return a.parseAnswer(value)
+	}
+
+	// we don't know anything about this prompt so leave the value as it is
+	return value, nil
+}
+
+// defaultAnswer returns the answer a prompt would give if the user pressed enter straight away.
+func defaultAnswer(p Prompt) (interface{}, error) {
+	if a, ok := p.(sourceAnswerer); ok {
+		return a.defaultAnswer()
+	}
+	return nil, fmt.Errorf("cannot answer %T without prompting", p)
+}
+
+// textAnswer converts a value provided by an AnswerSource into the answer of a prompt for text
+func textAnswer(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+	return fmt.Sprint(value)
+}
+
+// sourceList converts a value provided by an AnswerSource into a list of strings, splitting a
+// string on commas. what names the prompt in the error for the values of other types.
+func sourceList(value interface{}, what string) ([]string, error) {
+	var values []string
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			values = strings.Split(v, ",")
+		}
+	case []string:
+		values = v
+	case []interface{}:
+		for _, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot use %T as the answer to %s", item, what)
+			}
+			values = append(values, str)
+		}
+	default:
+		return nil, fmt.Errorf("cannot use %T as the answer to %s", value, what)
+	}
+	return values, nil
+}
+
+// wholeNumber returns the int for a number provided by an AnswerSource, including the float64s
+// decoded from JSON, as long as it has no fraction.
+func wholeNumber(value interface{}) (int, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f == math.Trunc(f) {
+			return int(f), true
+		}
+	}
+	return 0, false
+}
+
+// findOption returns the answer for the option with the given value.
+func findOption(options []string, value string) (core.OptionAnswer, error) {
+	for i, opt := range options {
+		if opt == value {
+			return core.OptionAnswer{Value: opt, Index: i}, nil
+		}
+	}
+	return core.OptionAnswer{}, fmt.Errorf("%q is not one of the options", value)
+}
@@ -0,0 +1,250 @@
+package survey
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func sourceQuestions() []*Question {
+	return []*Question{
+		{
+			Name:      "name",
+			Prompt:    &Input{Message: "What is your name?"},
+			Validate:  Required,
+			Transform: Title,
+		},
+		{
+			Name:   "pie",
+			Prompt: &Confirm{Message: "Do you like pie?"},
+		},
+		{
+			Name: "color",
+			Prompt: &Select{
+				Message: "Choose a color:",
+				Options: []string{"red", "blue", "green"},
+			},
+		},
+		{
+			Name: "days",
+			Prompt: &MultiSelect{
+				Message: "What days do you prefer:",
+				Options: []string{"Sunday", "Monday", "Tuesday"},
+			},
+		},
+	}
+}
+
+type sourceAnswers struct {
+	Name  string
+	Pie   bool
+	Color string
+	Days  []string
+}
+
+func TestAsk_MapSource(t *testing.T) {
+	res := sourceAnswers{}
+	err := Ask(sourceQuestions(), &res, WithAnswerSource(MapSource{
+		"name":  "johnny appleseed",
+		"pie":   "yes",
+		"color": "blue",
+		"days":  []interface{}{"Sunday", "Tuesday"},
+	}))
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, sourceAnswers{
+		Name:  "Johnny Appleseed",
+		Pie:   true,
+		Color: "blue",
+		Days:  []string{"Sunday", "Tuesday"},
+	}, res)
+}
+
+func TestAsk_MapSourceUsesDefaults(t *testing.T) {
+	qs := []*Question{
+		{
+			Name:   "name",
+			Prompt: &Input{Message: "What is your name?", Default: "Johnny"},
+		},
+		{
+			Name:   "color",
+			Prompt: &Select{Message: "Choose a color:", Options: []string{"red", "blue"}, Default: "blue"},
+		},
+	}
+
+	res := map[string]interface{}{}
+	if err := Ask(qs, &res, WithAnswerSource(MapSource{})); err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"name":  "Johnny",
+		"color": core.OptionAnswer{Value: "blue", Index: 1},
+	}, res)
+}
+
+func TestAsk_MapSourceTree(t *testing.T) {
+	qs := []*Question{
+		{
+			Name:   "resource",
+			Prompt: &TreeSelect{Message: "Choose a resource:", Options: resourceTree()},
+		},
+		{
+			Name:   "others",
+			Prompt: &MultiTreeSelect{Message: "Choose resources:", Options: resourceTree()},
+		},
+		{
+			Name:   "fallback",
+			Prompt: &TreeSelect{Message: "Choose a resource:", Options: resourceTree(), Default: []string{"kube-system", "pods"}},
+		},
+	}
+
+	res := struct {
+		Resource []string
+		Others   []string
+		Fallback TreeAnswer
+	}{}
+	err := Ask(qs, &res, WithAnswerSource(MapSource{
+		"resource": []interface{}{"default", "services", "web"},
+		"others":   "coredns, default/deployments",
+	}))
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, []string{"default", "services", "web"}, res.Resource)
+	assert.Equal(t, []string{"deployments", "coredns"}, res.Others)
+	assert.Equal(t, TreeAnswer{Value: "pods", Path: []string{"kube-system", "pods"}, Index: 7}, res.Fallback)
+}
+
+func TestAsk_MapSourceMissing(t *testing.T) {
+	qs := append(sourceQuestions(), &Question{
+		Name:     "password",
+		Prompt:   &Password{Message: "Password:"},
+		Validate: Required,
+	})
+
+	res := sourceAnswers{}
+	err := Ask(qs, &res, WithAnswerSource(MapSource{"color": "green"}))
+
+	missing, ok := err.(*MissingAnswersError)
+	if !ok {
+		t.Fatalf("Ask() = %v, want a MissingAnswersError", err)
+	}
+	assert.Equal(t, []string{"name", "password"}, missing.Names)
+	assert.Equal(t, "missing answers for: name, password", err.Error())
+	// the questions that could be answered are still written
+	assert.Equal(t, "green", res.Color)
+}
+
+func TestAsk_MapSourceInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		source MapSource
+	}{
+		{"failed validation", MapSource{"name": ""}},
+		{"unknown option", MapSource{"name": "Johnny", "color": "purple"}},
+		{"unknown multiselect option", MapSource{"name": "Johnny", "days": "Sunday,Friday"}},
+		{"not a bool", MapSource{"name": "Johnny", "pie": "maybe"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := sourceAnswers{}
+			err := Ask(sourceQuestions(), &res, WithAnswerSource(test.source))
+			assert.Error(t, err)
+			_, missing := err.(*MissingAnswersError)
+			assert.False(t, missing)
+		})
+	}
+}
+
+func TestAsk_EnvSource(t *testing.T) {
+	vars := map[string]string{
+		"SURVEY_TEST_NAME":  "Johnny",
+		"SURVEY_TEST_PIE":   "n",
+		"SURVEY_TEST_COLOR": "green",
+		"SURVEY_TEST_DAYS":  "Monday, Tuesday",
+	}
+	for key, value := range vars {
+		os.Setenv(key, value)
+		defer os.Unsetenv(key)
+	}
+
+	res := sourceAnswers{}
+	if err := Ask(sourceQuestions(), &res, WithAnswerSource(EnvSource("SURVEY_TEST_"))); err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, sourceAnswers{
+		Name:  "Johnny",
+		Color: "green",
+		Days:  []string{"Monday", "Tuesday"},
+	}, res)
+}
+
+func TestEnvSource_Lookup(t *testing.T) {
+	os.Setenv("APP_DB_HOST", "localhost")
+	defer os.Unsetenv("APP_DB_HOST")
+
+	value, ok := EnvSource("APP_").Lookup("db-host")
+	assert.True(t, ok)
+	assert.Equal(t, "localhost", value)
+
+	_, ok = EnvSource("APP_").Lookup("db-port")
+	assert.False(t, ok)
+}
+
+func TestJSONFileSource(t *testing.T) {
+	f, err := ioutil.TempFile("", "survey*.json")
+	if err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(`{"name": "Johnny", "pie": true, "color": "red", "days": ["Monday"]}`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	source, err := JSONFileSource(f.Name())
+	if err != nil {
+		t.Fatalf("JSONFileSource() = %v", err)
+	}
+
+	res := sourceAnswers{}
+	if err := Ask(sourceQuestions(), &res, WithAnswerSource(source)); err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, sourceAnswers{
+		Name:  "Johnny",
+		Pie:   true,
+		Color: "red",
+		Days:  []string{"Monday"},
+	}, res)
+}
+
+func TestSourceAnswerer(t *testing.T) {
+	prompts := []Prompt{
+		&Input{}, &Password{}, &Editor{}, &Multiline{}, &Confirm{}, &Select{}, &MultiSelect{},
+		&Repeat{}, &Number{}, &DateTime{}, &Path{}, &Order{}, &Tags{}, &KeyValue{},
+		&TableSelect{}, &MultiTableSelect{}, &TreeSelect{}, &MultiTreeSelect{}, &Cascade{},
+	}
+	for _, p := range prompts {
+		_, ok := p.(sourceAnswerer)
+		assert.True(t, ok, "%T can't be answered from a source", p)
+	}
+
+	// prompts that can't be answered from a source keep the value
+	ans, err := parseAnswer(&mockPrompt{}, 42)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, ans)
+
+	_, err = defaultAnswer(&mockPrompt{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,191 @@
+package survey
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+/*
+QuestionsFromStruct builds a question for every exported field of the struct that v points to.
+Each question writes its answer back to the field it was built from, so the same pointer can be
+passed to Ask as the response. The questions are configured with the following tags:
+
+	message   the message of the prompt, defaults to the name of the field
+	help      the help text of the prompt
+	default   the default answer, in the same format an answer would be typed
+	options   comma separated options to choose from
+	validate  comma separated validators: required, minlength=N, maxlength=N, minitems=N, maxitems=N
+
+The prompt depends on the type of the field: bools are asked with a Confirm, fields with options
+with a Select (or a MultiSelect for slices) and strings, numbers and durations with an Input. The
+fields of any other type, like maps, structs or slices without options, are skipped along with the
+fields tagged with `survey:"-"`, so they keep their values. The survey tag still sets the name of
+the question.
+
+	type Config struct {
+		Name  string `message:"What is your name?" validate:"required"`
+		Color string `message:"Choose a color:" options:"red,blue,green" default:"red"`
+		Debug bool   `message:"Enable debugging?"`
+	}
+*/
+func QuestionsFromStruct(v interface{}) ([]*Question, error) {
+	fields, err := core.StructFields(v)
+	if err != nil {
+		return nil, err
+	}
+
+	qs := []*Question{}
+	for _, field := range fields {
+		if !canAskField(field) {
+			continue
+		}
+		q, err := questionFromField(field)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", field.Field.Name, err)
+		}
+		qs = append(qs, q)
+	}
+	return qs, nil
+}
+
+// AskStruct asks the questions built by QuestionsFromStruct and writes the answers
+// to the struct that v points to.
+func AskStruct(v interface{}, opts ...AskOpt) error {
+	qs, err := QuestionsFromStruct(v)
+	if err != nil {
+		return err
+	}
+	return Ask(qs, v, opts...)
+}
+
+// questionFromField builds the question for a single field of a struct
+func questionFromField(field core.StructField) (*Question, error) {
+	tag := field.Field.Tag
+
+	message := tag.Get("message")
+	if message == "" {
+		message = field.Field.Name
+	}
+	help := tag.Get("help")
+	def, hasDefault := tag.Lookup("default")
+	options := splitTag(tag.Get("options"))
+
+	validator, err := validatorFromTag(tag.Get("validate"))
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Question{Name: field.Name, Validate: validator}
+
+	fieldType := field.Field.Type
+	switch {
+	case fieldType.Kind() == reflect.Bool:
+		confirm := &Confirm{Message: message, Help: help}
+		if hasDefault {
+			if confirm.Default, err = strconv.ParseBool(def); err != nil {
+				return nil, fmt.Errorf("invalid default %q", def)
+			}
+		}
+		q.Prompt = confirm
+	case fieldType.Kind() == reflect.Slice:
+		multi := &MultiSelect{Message: message, Help: help, Options: options}
+		if hasDefault {
+			multi.Default = splitTag(def)
+		}
+		q.Prompt = multi
+	case len(options) > 0:
+		sel := &Select{Message: message, Help: help, Options: options}
+		if hasDefault {
+			sel.Default = def
+		}
+		q.Prompt = sel
+	default:
+		q.Prompt = &Input{Message: message, Help: help, Default: def}
+	}
+
+	return q, nil
+}
+
+// canAskField returns whether one of the prompts can answer the field
+func canAskField(field core.StructField) bool {
+	fieldType := field.Field.Type
+	hasOptions := len(splitTag(field.Field.Tag.Get("options"))) > 0
+	switch {
+	case fieldType.Kind() == reflect.Bool:
+		return true
+	case fieldType.Kind() == reflect.Slice:
+		return hasOptions
+	}
+	return hasOptions || isInputKind(fieldType)
+}
+
+// isInputKind returns true if the answer of an Input can be written to the type
+func isInputKind(t reflect.Type) bool {
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// the validators of the validate tag that take a length
+var lengthValidators = map[string]func(int) Validator{
+	"minlength": MinLength,
+	"maxlength": MaxLength,
+	"minitems":  MinItems,
+	"maxitems":  MaxItems,
+}
+
+// validatorFromTag builds the validator described by the value of a validate tag
+func validatorFromTag(tag string) (Validator, error) {
+	validators := []Validator{}
+	for _, rule := range splitTag(tag) {
+		name, arg := rule, ""
+		if i := strings.Index(rule, "="); i >= 0 {
+			name, arg = rule[:i], rule[i+1:]
+		}
+
+		if name == "required" {
+			validators = append(validators, Required)
+			continue
+		}
+
+		build, ok := lengthValidators[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown validator %q", name)
+		}
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator %q", rule)
+		}
+		validators = append(validators, build(n))
+	}
+
+	if len(validators) == 0 {
+		return nil, nil
+	}
+	return ComposeValidators(validators...), nil
+}
+
+// splitTag splits a comma separated tag value and trims the spaces around each item
+func splitTag(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
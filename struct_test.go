@@ -0,0 +1,91 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type structConfig struct {
+	Name    string        `message:"What is your name?" help:"Your full name" validate:"required,minlength=2"`
+	Color   string        `survey:"color" message:"Choose a color:" options:"red, blue, green" default:"blue"`
+	Days    []string      `message:"Which days?" options:"Monday,Tuesday" default:"Tuesday"`
+	Debug   bool          `message:"Enable debugging?" default:"true"`
+	Timeout time.Duration `default:"5s"`
+	Ignored string        `survey:"-"`
+	private string
+}
+
+func TestQuestionsFromStruct(t *testing.T) {
+	qs, err := QuestionsFromStruct(&structConfig{})
+	assert.NoError(t, err)
+
+	if !assert.Len(t, qs, 5) {
+		return
+	}
+
+	assert.Equal(t, "Name", qs[0].Name)
+	assert.Equal(t, &Input{Message: "What is your name?", Help: "Your full name"}, qs[0].Prompt)
+	assert.Error(t, qs[0].Validate(""))
+	assert.Error(t, qs[0].Validate("J"))
+	assert.NoError(t, qs[0].Validate("Johnny"))
+
+	assert.Equal(t, "color", qs[1].Name)
+	assert.Equal(t, &Select{Message: "Choose a color:", Options: []string{"red", "blue", "green"}, Default: "blue"}, qs[1].Prompt)
+	assert.Nil(t, qs[1].Validate)
+
+	assert.Equal(t, &MultiSelect{Message: "Which days?", Options: []string{"Monday", "Tuesday"}, Default: []string{"Tuesday"}}, qs[2].Prompt)
+	assert.Equal(t, &Confirm{Message: "Enable debugging?", Default: true}, qs[3].Prompt)
+	assert.Equal(t, &Input{Message: "Timeout", Default: "5s"}, qs[4].Prompt)
+}
+
+func TestQuestionsFromStruct_Invalid(t *testing.T) {
+	_, err := QuestionsFromStruct(structConfig{})
+	assert.Error(t, err)
+
+	_, err = QuestionsFromStruct(&struct {
+		Name string `validate:"maxlength"`
+	}{})
+	assert.EqualError(t, err, `field Name: invalid validator "maxlength"`)
+
+	_, err = QuestionsFromStruct(&struct {
+		Name string `validate:"email"`
+	}{})
+	assert.EqualError(t, err, `field Name: unknown validator "email"`)
+}
+
+func TestQuestionsFromStruct_SkipsFieldsThatCantBeAsked(t *testing.T) {
+	qs, err := QuestionsFromStruct(&struct {
+		Name   string
+		Tags   []string
+		Values map[string]string
+		Nested struct{ Port int }
+		Days   []string `options:"Monday,Tuesday"`
+	}{})
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, q := range qs {
+		names = append(names, q.Name)
+	}
+	assert.Equal(t, []string{"Name", "Days"}, names)
+}
+
+func TestAskStruct(t *testing.T) {
+	cfg := structConfig{}
+
+	err := AskStruct(&cfg, WithAnswerSource(MapSource{
+		"Name": "Johnny",
+		"Days": "Monday,Tuesday",
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, structConfig{
+		Name:    "Johnny",
+		Color:   "blue",
+		Days:    []string{"Monday", "Tuesday"},
+		Debug:   true,
+		Timeout: 5 * time.Second,
+	}, cfg)
+}
@@ -2,9 +2,12 @@ package survey
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strings"
 	"unicode/utf8"
 
@@ -49,6 +52,10 @@ func defaultAskOptions() *AskOptions {
 					Text:   ">",
 					Format: "cyan+b",
 				},
+				GrabbedOption: Icon{
+					Text:   "=",
+					Format: "yellow+b",
+				},
 			},
 			Filter: func(filter string, value string, index int) (include bool) {
 				filter = strings.ToLower(filter)
@@ -75,6 +82,12 @@ func defaultIcons() *IconSet {
 // OptionAnswer is an ergonomic alias for core.OptionAnswer
 type OptionAnswer = core.OptionAnswer
 
+// OtherIndex is an ergonomic alias for core.OtherIndex
+const OtherIndex = core.OtherIndex
+
+// TreeAnswer is an ergonomic alias for core.TreeAnswer
+type TreeAnswer = core.TreeAnswer
+
 // Icon holds the text and format to show for a particular icon
 type Icon struct {
 	Text   string
@@ -90,6 +103,7 @@ type IconSet struct {
 	MarkedOption   Icon
 	UnmarkedOption Icon
 	SelectFocus    Icon
+	GrabbedOption  Icon
 }
 
 // Validator is a function passed to a Question after a user has provided a response.
@@ -104,12 +118,31 @@ type Validator func(ans interface{}) error
 // Look `TransformString`, `ToLower` `Title` and `ComposeTransformers` for more.
 type Transformer func(ans interface{}) (newAns interface{})
 
+// Answers holds the responses collected so far in a call to Ask, keyed by
+// the Name of the question that produced them. The values are the answers
+// after any Transformer has been applied.
+type Answers map[string]interface{}
+
+// CrossValidator is like a Validator but also receives a copy of the answers collected
+// so far, so that an answer can be checked against the answers to previous questions.
+type CrossValidator func(ans interface{}, answers Answers) error
+
+// Condition is a function passed to a Question to decide whether it should be
+// asked. It receives a copy of the answers collected so far.
+type Condition func(answers Answers) bool
+
 // Question is the core data structure for a survey questionnaire.
 type Question struct {
 	Name      string
 	Prompt    Prompt
 	Validate  Validator
 	Transform Transformer
+	// CrossValidate is run after Validate with the answers to the previous questions.
+	CrossValidate CrossValidator
+	// When decides whether the question is asked. If it returns false, the
+	// question is skipped and SkipDefault (if not nil) is written instead.
+	When        Condition
+	SkipDefault interface{}
 }
 
 // PromptConfig holds the global configuration for a prompt
@@ -124,6 +157,8 @@ type PromptConfig struct {
 	RemoveSelectAll  bool
 	RemoveSelectNone bool
 	HideCharacter    rune
+	BackKey          rune
+	Hotkeys          bool
 }
 
 // Prompt is the primary interface for the objects that can take user input
@@ -139,6 +174,16 @@ type PromptAgainer interface {
 	PromptAgain(config *PromptConfig, invalid interface{}, err error) (interface{}, error)
 }
 
+// DefaultSetter Interface for Prompts that can use a previous answer as their default.
+// SetDefault returns an error if the value cannot be used, leaving the default unchanged.
+type DefaultSetter interface {
+	SetDefault(value interface{}) error
+}
+
+// ErrGoBack is returned by prompts when the user presses the configured BackKey. Ask
+// handles it by returning to the previous question.
+var ErrGoBack = errors.New("go back to the previous question")
+
 // AskOpt allows setting optional ask options.
 type AskOpt func(options *AskOptions) error
 
@@ -147,6 +192,11 @@ type AskOptions struct {
 	Stdio        terminal.Stdio
 	Validators   []Validator
 	PromptConfig PromptConfig
+	AnswerSource AnswerSource
+	// use the values already in the response as the defaults of the prompts
+	DefaultsFromResponse bool
+	// show a summary of the answers at the end of Ask
+	Review bool
 }
 
 // WithStdio specifies the standard input, output and error files survey
@@ -197,6 +247,14 @@ func WithRemoveSelectNone() AskOpt {
 	}
 }
 
+// WithHotkeys lets the user pick an option of a Select or a MultiSelect by pressing its key
+func WithHotkeys() AskOpt {
+	return func(options *AskOptions) error {
+		options.PromptConfig.Hotkeys = true
+		return nil
+	}
+}
+
 // WithValidator specifies a validator to use while prompting the user
 func WithValidator(v Validator) AskOpt {
 	return func(options *AskOptions) error {
@@ -212,6 +270,10 @@ type wantsStdio interface {
 	WithStdio(terminal.Stdio)
 }
 
+type wantsClear interface {
+	clearRendered()
+}
+
 // WithPageSize sets the default page size used by prompts
 func WithPageSize(pageSize int) AskOpt {
 	return func(options *AskOptions) error {
@@ -267,6 +329,42 @@ func WithHideCharacter(char rune) AskOpt {
 	}
 }
 
+// WithBackKey sets the key that returns to the previous question when asking a list of questions
+func WithBackKey(key rune) AskOpt {
+	return func(options *AskOptions) error {
+		// set the back key
+		options.PromptConfig.BackKey = key
+
+		// nothing went wrong
+		return nil
+	}
+}
+
+// WithDefaultsFromResponse uses the value already stored in the response for each question as the
+// default of its prompt, so running the same questions again offers the previous answers. Zero values
+// are ignored and the prompt keeps its own default.
+func WithDefaultsFromResponse() AskOpt {
+	return func(options *AskOptions) error {
+		// read the defaults from the response
+		options.DefaultsFromResponse = true
+
+		// nothing went wrong
+		return nil
+	}
+}
+
+// WithReview shows a summary of every answer once all of the questions were asked. The user can
+// pick any of them to answer the question again, and Ask returns once they are done.
+func WithReview() AskOpt {
+	return func(options *AskOptions) error {
+		// review the answers at the end
+		options.Review = true
+
+		// nothing went wrong
+		return nil
+	}
+}
+
 /*
 AskOne performs the prompt for a single prompt and asks for validation if required.
 Response types should be something that can be casted from the response type designated
@@ -280,7 +378,13 @@ in the documentation. For example:
 	survey.AskOne(prompt, &name)
 */
 func AskOne(p Prompt, response interface{}, opts ...AskOpt) error {
-	err := Ask([]*Question{{Prompt: p}}, response, opts...)
+	return AskOneContext(context.Background(), p, response, opts...)
+}
+
+// AskOneContext is like AskOne but stops waiting for the user once the context is done,
+// returning ctx.Err().
+func AskOneContext(ctx context.Context, p Prompt, response interface{}, opts ...AskOpt) error {
+	err := AskContext(ctx, []*Question{{Prompt: p}}, response, opts...)
 	if err != nil {
 		return err
 	}
@@ -295,7 +399,8 @@ the field whose name matches the Name field on the corresponding question. Field
 should be something that can be casted from the response type designated in the
 documentation. Note, a survey tag can also be used to identify a Otherwise, a
 map[string]interface{} can be passed, responses will be written to the key with the
-matching name. For example:
+matching name. Maps with other types of values work too, as long as every answer can be
+converted to that type. For example:
 
 	qs := []*survey.Question{
 		{
@@ -312,6 +417,23 @@ matching name. For example:
 	err := survey.Ask(qs, &answers)
 */
 func Ask(qs []*Question, response interface{}, opts ...AskOpt) error {
+	return AskContext(context.Background(), qs, response, opts...)
+}
+
+/*
+AskContext is like Ask but stops waiting for the user once the context is done. The
+prompt that was being shown is removed, the terminal is restored and ctx.Err() is
+returned. For example:
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := survey.AskContext(ctx, qs, &answers)
+	if errors.Is(err, context.DeadlineExceeded) {
+		// the user took too long to answer
+	}
+*/
+func AskContext(ctx context.Context, qs []*Question, response interface{}, opts ...AskOpt) error {
 	// build up the configuration options
 	options := defaultAskOptions()
 	for _, opt := range opts {
@@ -329,75 +451,354 @@ func Ask(qs []*Question, response interface{}, opts ...AskOpt) error {
 		return errors.New("cannot call Ask() with a nil reference to record the answers")
 	}
 
-	validate := func(q *Question, val interface{}) error {
-		if q.Validate != nil {
-			if err := q.Validate(val); err != nil {
-				return err
-			}
-		}
-		for _, v := range options.Validators {
-			if err := v(val); err != nil {
-				return err
-			}
-		}
-		return nil
+	// if the context can be cancelled, make sure reading input can be interrupted
+	if ctx.Done() != nil {
+		options.Stdio.In = terminal.NewContextReader(ctx, options.Stdio.In)
 	}
 
+	return ask(ctx, qs, response, options)
+}
+
+// ask runs every question in order and writes the answers to the response.
+func ask(ctx context.Context, qs []*Question, response interface{}, options *AskOptions) error {
+	// the answers we have collected so far
+	answers := Answers{}
+	// the untransformed answer to every question, used as defaults when going back
+	given := make([]interface{}, len(qs))
+	// the indices of the questions that were asked, in order
+	history := []int{}
+	// the questions an answer source could not answer
+	missing := []string{}
+
 	// go over every question
-	for _, q := range qs {
-		// If Prompt implements controllable stdio, pass in specified stdio.
-		if p, ok := q.Prompt.(wantsStdio); ok {
-			p.WithStdio(options.Stdio)
-		}
-
-		var ans interface{}
-		var validationErr error
-		// prompt and validation loop
-		for {
-			if validationErr != nil {
-				if err := q.Prompt.Error(&options.PromptConfig, validationErr); err != nil {
+	for i := 0; i < len(qs); {
+		q := qs[i]
+
+		// don't ask anything else if we have been stopped
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// if the question should be skipped given the previous answers
+		if q.When != nil && !q.When(answers.copy()) {
+			// leave the target untouched unless we were told what to write
+			if q.SkipDefault != nil {
+				if err := writeAnswer(response, q.Name, q.SkipDefault, options.DefaultsFromResponse); err != nil {
 					return err
 				}
+				answers[q.Name] = q.SkipDefault
 			}
-			var err error
-			if promptAgainer, ok := q.Prompt.(PromptAgainer); ok && validationErr != nil {
-				ans, err = promptAgainer.PromptAgain(&options.PromptConfig, ans, validationErr)
-			} else {
-				ans, err = q.Prompt.Prompt(&options.PromptConfig)
-			}
-			if err != nil {
-				return err
-			}
-			validationErr = validate(q, ans)
-			if validationErr == nil {
-				break
+			i++
+			continue
+		}
+
+		// offer the current value of the response as the default the first time we get here
+		if options.DefaultsFromResponse && given[i] == nil {
+			if d, ok := q.Prompt.(DefaultSetter); ok {
+				if value, err := core.ReadAnswer(response, q.Name); err == nil {
+					if def := responseDefault(q.Prompt, value); def != nil {
+						_ = d.SetDefault(def)
+					}
+				}
 			}
 		}
 
-		if q.Transform != nil {
-			// check if we have a transformer available, if so
-			// then try to acquire the new representation of the
-			// answer, if the resulting answer is not nil.
-			if newAns := q.Transform(ans); newAns != nil {
-				ans = newAns
+		// answer the question from the source if there is one, otherwise ask the user
+		var raw, ans interface{}
+		var err error
+		if options.AnswerSource != nil {
+			raw, ans, err = answerFromSource(ctx, q, options, answers)
+			if _, ok := err.(errNoAnswer); ok {
+				missing = append(missing, q.Name)
+				i++
+				continue
 			}
+		} else {
+			raw, ans, err = askPrompt(ctx, q, options, answers)
 		}
+		if err == ErrGoBack {
+			clearPrompt(q.Prompt)
+
+			// if there is a question to go back to
+			if len(history) > 0 {
+				i = history[len(history)-1]
+				history = history[:len(history)-1]
+				delete(answers, qs[i].Name)
+
+				// the questions after it are answered again, or skipped if they no longer apply
+				for j := i + 1; j < len(qs); j++ {
+					if _, ok := answers[qs[j].Name]; !ok && given[j] == nil {
+						continue
+					}
+					if err := core.ResetAnswer(response, qs[j].Name); err != nil {
+						return err
+					}
+					delete(answers, qs[j].Name)
+					given[j] = nil
+				}
 
-		// tell the prompt to cleanup with the validated value
-		if err := q.Prompt.Cleanup(&options.PromptConfig, ans); err != nil {
+				// remove the old answer and offer it as the default instead
+				clearPrompt(qs[i].Prompt)
+				if d, ok := qs[i].Prompt.(DefaultSetter); ok {
+					_ = d.SetDefault(given[i])
+				}
+			}
+			continue
+		}
+		if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+			// the prompt was interrupted, don't leave it behind
+			clearPrompt(q.Prompt)
+			return ctxErr
+		}
+		if err != nil {
 			return err
 		}
 
-		// add it to the map
-		if err := core.WriteAnswer(response, q.Name, ans); err != nil {
+		// add it to the map, replacing the answer given before going back or the default
+		if err := writeAnswer(response, q.Name, ans, options.DefaultsFromResponse || given[i] != nil); err != nil {
 			return err
 		}
+		answers[q.Name] = ans
+		given[i] = raw
+		history = append(history, i)
+		i++
+	}
+
+	// make sure we weren't missing anything
+	if len(missing) > 0 {
+		return &MissingAnswersError{Names: missing}
+	}
+
+	// let the user look over the answers before we are done
+	if options.Review && options.AnswerSource == nil && len(history) > 0 {
+		return review(ctx, qs, history, answers, given, response, options)
 	}
 
 	// return the response
 	return nil
 }
 
+// writeAnswer writes an answer to the response. WriteAnswer adds the items of a list to the ones
+// already in the response, so the answer is written over a reset field when it replaces an
+// answer written before or a value that was offered as the default.
+func writeAnswer(response interface{}, name string, ans interface{}, replace bool) error {
+	if replace {
+		if err := core.ResetAnswer(response, name); err != nil {
+			return err
+		}
+	}
+	return core.WriteAnswer(response, name, ans)
+}
+
+// askPrompt asks a question with the loop that fits its prompt.
+func askPrompt(ctx context.Context, q *Question, options *AskOptions, answers Answers) (interface{}, interface{}, error) {
+	if r, ok := q.Prompt.(*Repeat); ok {
+		return askRepeat(ctx, q, r, options, answers)
+	}
+	return askQuestion(q, options, answers)
+}
+
+// askQuestion runs the prompt and validation loop for a single question. It returns both
+// the answer given by the user and the answer after the question's Transformer was applied.
+func askQuestion(q *Question, options *AskOptions, answers Answers) (interface{}, interface{}, error) {
+	// If Prompt implements controllable stdio, pass in specified stdio.
+	if p, ok := q.Prompt.(wantsStdio); ok {
+		p.WithStdio(options.Stdio)
+	}
+
+	var ans interface{}
+	var validationErr error
+	// prompt and validation loop
+	for {
+		if validationErr != nil {
+			if err := q.Prompt.Error(&options.PromptConfig, validationErr); err != nil {
+				return nil, nil, err
+			}
+		}
+		var err error
+		if promptAgainer, ok := q.Prompt.(PromptAgainer); ok && validationErr != nil {
+			ans, err = promptAgainer.PromptAgain(&options.PromptConfig, ans, validationErr)
+		} else {
+			ans, err = q.Prompt.Prompt(&options.PromptConfig)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		validationErr = validateAnswer(q, options, answers, ans)
+		if validationErr == nil {
+			break
+		}
+	}
+
+	raw := ans
+	ans = transformAnswer(q, ans)
+
+	// tell the prompt to cleanup with the validated value
+	if err := q.Prompt.Cleanup(&options.PromptConfig, ans); err != nil {
+		return nil, nil, err
+	}
+
+	return raw, ans, nil
+}
+
+// validateAnswer runs the validators of the question and the global validators against an answer.
+func validateAnswer(q *Question, options *AskOptions, answers Answers, ans interface{}) error {
+	if q.Validate != nil {
+		if err := q.Validate(ans); err != nil {
+			return err
+		}
+	}
+	if q.CrossValidate != nil {
+		if err := q.CrossValidate(ans, answers.copy()); err != nil {
+			return err
+		}
+	}
+	for _, v := range options.Validators {
+		if err := v(ans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transformAnswer applies the transformer of the question to an answer.
+func transformAnswer(q *Question, ans interface{}) interface{} {
+	if q.Transform != nil {
+		// check if we have a transformer available, if so
+		// then try to acquire the new representation of the
+		// answer, if the resulting answer is not nil.
+		if newAns := q.Transform(ans); newAns != nil {
+			return newAns
+		}
+	}
+	return ans
+}
+
+// responseDefault converts a value read from the response into something the SetDefault method
+// of the prompt accepts. It returns nil if the value is empty or can't be used as a default.
+func responseDefault(p Prompt, value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || isZero(v) {
+		return nil
+	}
+
+	// the response holds the data of the options when they have any
+	if def, ok := dataDefault(p, value); ok {
+		return def
+	}
+
+	switch p.(type) {
+	case *Input, *Editor, *Multiline, *Path:
+		if s, ok := value.(fmt.Stringer); ok {
+			return s.String()
+		}
+		switch v.Kind() {
+		case reflect.String:
+			return v.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return fmt.Sprint(value)
+		}
+	case *Confirm:
+		if v.Kind() == reflect.Bool {
+			return v.Bool()
+		}
+	case *DateTime, *TreeSelect, *MultiTreeSelect, *Cascade:
+		return value
+	case *Number:
+		// durations are numbers too but not the ones the user would type
+		if _, ok := value.(fmt.Stringer); !ok {
+			return value
+		}
+	case *Select, *TableSelect:
+		switch {
+		case v.Type() == reflect.TypeOf(core.OptionAnswer{}):
+			return value
+		case v.Kind() == reflect.String:
+			return v.String()
+		case v.Kind() == reflect.Int:
+			return int(v.Int())
+		}
+	case *Tags:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil
+		}
+		values := []string{}
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, fmt.Sprint(v.Index(i).Interface()))
+		}
+		return values
+	case *KeyValue:
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		pairs := map[string]string{}
+		for _, key := range v.MapKeys() {
+			pairs[key.String()] = fmt.Sprint(v.MapIndex(key).Interface())
+		}
+		return pairs
+	case *Order:
+		if v.Type() == reflect.TypeOf([]core.OptionAnswer{}) || v.Type() == reflect.TypeOf([]string{}) {
+			return value
+		}
+	case *MultiSelect, *MultiTableSelect:
+		if v.Type() == reflect.TypeOf([]core.OptionAnswer{}) {
+			return value
+		}
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil
+		}
+		switch v.Type().Elem().Kind() {
+		case reflect.String:
+			values := []string{}
+			for i := 0; i < v.Len(); i++ {
+				values = append(values, v.Index(i).String())
+			}
+			return values
+		case reflect.Int:
+			indices := []int{}
+			for i := 0; i < v.Len(); i++ {
+				indices = append(indices, int(v.Index(i).Int()))
+			}
+			return indices
+		}
+	default:
+		// let other prompts decide what they can use
+		return value
+	}
+
+	return nil
+}
+
+// goBackOnRune returns a terminal.OnRuneFn that stops reading a line when the
+// user presses the configured BackKey.
+func goBackOnRune(config *PromptConfig) terminal.OnRuneFn {
+	return func(key rune, line []rune) ([]rune, bool, error) {
+		if config.BackKey != 0 && key == config.BackKey {
+			return line, true, ErrGoBack
+		}
+		return line, false, nil
+	}
+}
+
+// clearPrompt erases everything a prompt has rendered, if it knows how to.
+func clearPrompt(p Prompt) {
+	if c, ok := p.(wantsClear); ok {
+		c.clearRendered()
+	}
+}
+
+// copy returns a shallow copy of the answers so that callbacks can't modify
+// the answers collected by Ask.
+func (a Answers) copy() Answers {
+	c := make(Answers, len(a))
+	for k, v := range a {
+		c[k] = v
+	}
+	return c
+}
+
 // paginate returns a single page of choices given the page size, the total list of
 // possible choices, and the current selected index in the total list.
 func paginate(pageSize int, choices []core.OptionAnswer, sel int) ([]core.OptionAnswer, int) {
@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"context"
 	"errors"
 	"os/exec"
 	"strings"
@@ -129,6 +130,22 @@ func RunPromptTestRemoveSelectNone(t *testing.T, test PromptTest) {
 	require.Equal(t, test.expected, answer)
 }
 
+func RunPromptTestHotkeys(t *testing.T, test PromptTest) {
+	t.Helper()
+	var answer interface{}
+	RunTest(t, test.procedure, func(stdio terminal.Stdio) error {
+		var err error
+		if p, ok := test.prompt.(wantsStdio); ok {
+			p.WithStdio(stdio)
+		}
+		config := defaultPromptConfig()
+		config.Hotkeys = true
+		answer, err = test.prompt.Prompt(config)
+		return err
+	})
+	require.Equal(t, test.expected, answer)
+}
+
 func TestPagination_tooFew(t *testing.T) {
 	// a small list of options
 	choices := core.OptionAnswerList([]string{"choice1", "choice2", "choice3"})
@@ -668,3 +685,467 @@ func (p *mockPrompt) Error(_ *PromptConfig, err error) error {
 	p.printedErrors = append(p.printedErrors, err)
 	return nil
 }
+
+func TestAsk_When(t *testing.T) {
+	database := &mockPrompt{answers: []string{"sqlite"}}
+	password := &mockPrompt{answers: []string{"secret"}}
+	path := &mockPrompt{answers: []string{"/tmp/db"}}
+
+	res := struct {
+		Database string
+		Password string
+		Path     string
+		Port     int
+	}{Password: "untouched"}
+
+	err := Ask([]*Question{
+		{
+			Name:   "database",
+			Prompt: database,
+		},
+		{
+			Name:   "password",
+			Prompt: password,
+			When: func(answers Answers) bool {
+				return answers["database"] == "postgres"
+			},
+		},
+		{
+			Name:   "path",
+			Prompt: path,
+			When: func(answers Answers) bool {
+				return answers["database"] == "sqlite"
+			},
+		},
+		{
+			Name:   "port",
+			Prompt: &mockPrompt{answers: []string{"5432"}},
+			When: func(answers Answers) bool {
+				return answers["database"] == "postgres"
+			},
+			SkipDefault: 0,
+		},
+	}, &res)
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, "sqlite", res.Database)
+	assert.Equal(t, "untouched", res.Password)
+	assert.Equal(t, "/tmp/db", res.Path)
+	assert.Equal(t, 0, res.Port)
+	assert.Equal(t, 0, password.index)
+	assert.Equal(t, 1, path.index)
+}
+
+func TestAsk_WhenCannotModifyAnswers(t *testing.T) {
+	res := map[string]interface{}{}
+
+	err := Ask([]*Question{
+		{
+			Name:   "first",
+			Prompt: &mockPrompt{answers: []string{"one"}},
+		},
+		{
+			Name:   "second",
+			Prompt: &mockPrompt{answers: []string{"two"}},
+			When: func(answers Answers) bool {
+				answers["first"] = "modified"
+				return true
+			},
+		},
+		{
+			Name:   "third",
+			Prompt: &mockPrompt{answers: []string{"three"}},
+			When: func(answers Answers) bool {
+				return answers["first"] == "one"
+			},
+		},
+	}, &res)
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, map[string]interface{}{"first": "one", "second": "two", "third": "three"}, res)
+}
+
+// scriptedPrompt returns its answers in order, where an error in the list is returned
+// instead of an answer. It records any defaults it is given.
+type scriptedPrompt struct {
+	mockPrompt
+	script   []interface{}
+	defaults []interface{}
+}
+
+func (p *scriptedPrompt) Prompt(*PromptConfig) (interface{}, error) {
+	if p.index >= len(p.script) {
+		return nil, errors.New("no more answers")
+	}
+	val := p.script[p.index]
+	p.index++
+	if err, ok := val.(error); ok {
+		return nil, err
+	}
+	return val, nil
+}
+
+func (p *scriptedPrompt) SetDefault(value interface{}) error {
+	p.defaults = append(p.defaults, value)
+	return nil
+}
+
+func TestAsk_GoBack(t *testing.T) {
+	first := &scriptedPrompt{script: []interface{}{"one", "uno"}}
+	skipped := &scriptedPrompt{script: []interface{}{"never"}}
+	second := &scriptedPrompt{script: []interface{}{ErrGoBack, "two"}}
+
+	res := map[string]interface{}{}
+	err := Ask([]*Question{
+		{
+			Name:      "first",
+			Prompt:    first,
+			Transform: ToLower,
+		},
+		{
+			Name:   "skipped",
+			Prompt: skipped,
+			When: func(Answers) bool {
+				return false
+			},
+		},
+		{
+			Name:   "second",
+			Prompt: second,
+		},
+	}, &res)
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, map[string]interface{}{"first": "uno", "second": "two"}, res)
+	assert.Equal(t, []interface{}{"one"}, first.defaults)
+	assert.Equal(t, 0, skipped.index)
+}
+
+func TestAsk_GoBackForgetsLaterAnswers(t *testing.T) {
+	res := struct {
+		Database string
+		Password string
+		Port     int
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "database", Prompt: &scriptedPrompt{script: []interface{}{"postgres", "sqlite"}}},
+		{
+			Name:   "password",
+			Prompt: &scriptedPrompt{script: []interface{}{"secret", ErrGoBack}},
+			When: func(answers Answers) bool {
+				return answers["database"] == "postgres"
+			},
+		},
+		{
+			Name:        "port",
+			Prompt:      &scriptedPrompt{script: []interface{}{ErrGoBack}},
+			SkipDefault: 0,
+			When: func(answers Answers) bool {
+				return answers["database"] == "postgres"
+			},
+		},
+	}, &res)
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, "sqlite", res.Database)
+	assert.Equal(t, "", res.Password)
+	assert.Equal(t, 0, res.Port)
+}
+
+func TestAsk_GoBackReplacesLists(t *testing.T) {
+	res := struct {
+		Days  []string
+		Other string
+	}{Days: []string{"Sunday"}}
+
+	err := Ask([]*Question{
+		{Name: "days", Prompt: &scriptedPrompt{script: []interface{}{
+			[]string{"Monday"},
+			[]string{"Tuesday"},
+		}}},
+		{Name: "other", Prompt: &scriptedPrompt{script: []interface{}{ErrGoBack, "done"}}},
+	}, &res)
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	// the answer given after going back replaces the whole slice
+	assert.Equal(t, []string{"Tuesday"}, res.Days)
+}
+
+func TestAsk_GoBackFromFirstQuestion(t *testing.T) {
+	p := &scriptedPrompt{script: []interface{}{ErrGoBack, "one"}}
+
+	res := ""
+	if err := AskOne(p, &res); err != nil {
+		t.Fatalf("AskOne() = %v", err)
+	}
+
+	assert.Equal(t, "one", res)
+	assert.Empty(t, p.defaults)
+}
+
+func TestAsk_GoBackKey(t *testing.T) {
+	back := "\x07" // Ctrl+G
+
+	answers := map[string]interface{}{}
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("What is your name?")
+		c.SendLine("Johnny")
+		c.ExpectString("Choose a color:")
+		c.Send(back)
+		c.ExpectString("What is your name? (Johnny)")
+		c.SendLine("")
+		c.ExpectString("Choose a color:")
+		c.Send(string(terminal.KeyArrowDown))
+		c.SendLine("")
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{
+				Name:   "name",
+				Prompt: &Input{Message: "What is your name?"},
+			},
+			{
+				Name: "color",
+				Prompt: &Select{
+					Message: "Choose a color:",
+					Options: []string{"red", "blue", "green"},
+				},
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err), WithBackKey('\x07'))
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"name":  "Johnny",
+		"color": core.OptionAnswer{Index: 1, Value: "blue"},
+	}, answers)
+}
+
+func TestAsk_GoBackKeepsOtherAnswers(t *testing.T) {
+	back := "\x07" // Ctrl+G
+
+	answers := map[string]interface{}{}
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("Hobbies:")
+		c.Send(" ")
+		c.Send(string(terminal.KeyArrowUp))
+		c.Send(" ")
+		c.ExpectString("Type your answer")
+		c.Send("hiking")
+		c.SendLine("")
+		c.ExpectString("Other: hiking")
+		c.SendLine("")
+		c.ExpectString("What is your name?")
+		c.Send(back)
+		// the typed answer is still checked along with the option
+		c.ExpectString("Other: hiking")
+		c.SendLine("")
+		c.ExpectString("What is your name?")
+		c.SendLine("Johnny")
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		return Ask([]*Question{
+			{
+				Name: "hobbies",
+				Prompt: &MultiSelect{
+					Message: "Hobbies:",
+					Options: []string{"chess", "reading"},
+					Other:   "Other:",
+				},
+			},
+			{
+				Name:   "name",
+				Prompt: &Input{Message: "What is your name?"},
+			},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err), WithBackKey('\x07'))
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"hobbies": []core.OptionAnswer{{Value: "chess", Index: 0}, {Value: "hiking", Index: core.OtherIndex}},
+		"name":    "Johnny",
+	}, answers)
+}
+
+func TestAskContext_Cancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		prompt Prompt
+	}{
+		{"input", &Input{Message: "What is your name?"}},
+		{"select", &Select{Message: "What is your name?", Options: []string{"Larry", "Moe", "Curly"}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var askErr error
+			RunTest(t, func(c expectConsole) {
+				c.ExpectString("What is your name?")
+				cancel()
+				c.ExpectEOF()
+			}, func(stdio terminal.Stdio) error {
+				var answer interface{}
+				askErr = AskOneContext(ctx, test.prompt, &answer, WithStdio(stdio.In, stdio.Out, stdio.Err))
+				return nil
+			})
+
+			assert.Equal(t, context.Canceled, askErr)
+		})
+	}
+}
+
+func TestAskContext_StopsAsking(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var askErr error
+	answers := map[string]interface{}{}
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("What is your name?")
+		c.SendLine("Johnny")
+		c.ExpectString("Do you like pie?")
+		cancel()
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		askErr = AskContext(ctx, []*Question{
+			{Name: "name", Prompt: &Input{Message: "What is your name?"}},
+			{Name: "pie", Prompt: &Confirm{Message: "Do you like pie?"}},
+			{Name: "cake", Prompt: &Confirm{Message: "Do you like cake?"}},
+		}, &answers, WithStdio(stdio.In, stdio.Out, stdio.Err))
+		return nil
+	})
+
+	assert.Equal(t, context.Canceled, askErr)
+	assert.Equal(t, map[string]interface{}{"name": "Johnny"}, answers)
+}
+
+func TestAskContext_Timeout(t *testing.T) {
+	// long enough for the question to be shown before the deadline passes
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	var askErr error
+	RunTest(t, func(c expectConsole) {
+		c.ExpectString("Do you like pie?")
+		c.ExpectEOF()
+	}, func(stdio terminal.Stdio) error {
+		answer := false
+		askErr = AskOneContext(ctx, &Confirm{Message: "Do you like pie?"}, &answer, WithStdio(stdio.In, stdio.Out, stdio.Err))
+		return nil
+	})
+
+	assert.Equal(t, context.DeadlineExceeded, askErr)
+}
+
+func TestAsk_DefaultsFromResponse(t *testing.T) {
+	type config struct {
+		Name    string
+		Timeout time.Duration
+		Color   string
+		Days    []string
+		Debug   bool
+		Empty   string
+	}
+
+	qs := []*Question{
+		{Name: "name", Prompt: &Input{Message: "Name"}},
+		{Name: "timeout", Prompt: &Input{Message: "Timeout"}},
+		{Name: "color", Prompt: &Select{Message: "Color", Options: []string{"red", "blue"}}},
+		{Name: "days", Prompt: &MultiSelect{Message: "Days", Options: []string{"Monday", "Tuesday"}}},
+		{Name: "debug", Prompt: &Confirm{Message: "Debug"}},
+		{Name: "empty", Prompt: &Input{Message: "Empty", Default: "kept"}},
+	}
+
+	previous := config{
+		Name:    "Johnny",
+		Timeout: time.Minute,
+		Color:   "blue",
+		Days:    []string{"Tuesday"},
+		Debug:   true,
+	}
+	cfg := previous
+
+	// without any answers every question is answered with its default
+	err := Ask(qs, &cfg, WithAnswerSource(MapSource{}), WithDefaultsFromResponse())
+	assert.NoError(t, err)
+
+	previous.Empty = "kept"
+	assert.Equal(t, previous, cfg)
+	assert.Equal(t, "1m0s", qs[1].Prompt.(*Input).Default)
+	assert.Equal(t, "blue", qs[2].Prompt.(*Select).Default)
+}
+
+func TestAsk_DefaultsFromMap(t *testing.T) {
+	prompt := &Select{Message: "Color", Options: []string{"red", "blue"}}
+	answers := map[string]interface{}{
+		"color": core.OptionAnswer{Value: "blue", Index: 1},
+	}
+
+	err := Ask([]*Question{{Name: "color", Prompt: prompt}}, &answers, WithAnswerSource(MapSource{}), WithDefaultsFromResponse())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, prompt.Default)
+}
+
+func TestAsk_CrossValidate(t *testing.T) {
+	confirmation := &mockPrompt{answers: []string{"secert", "secret"}}
+
+	res := map[string]interface{}{}
+	err := Ask([]*Question{
+		{
+			Name:   "password",
+			Prompt: &mockPrompt{answers: []string{"secret"}},
+		},
+		{
+			Name:   "confirm",
+			Prompt: confirmation,
+			CrossValidate: func(ans interface{}, answers Answers) error {
+				// changes should not be visible to anyone else
+				defer func() { answers["password"] = "modified" }()
+
+				if ans != answers["password"] {
+					return errors.New("passwords do not match")
+				}
+				return nil
+			},
+		},
+	}, &res)
+	if err != nil {
+		t.Fatalf("Ask() = %v", err)
+	}
+
+	assert.Equal(t, map[string]interface{}{"password": "secret", "confirm": "secret"}, res)
+	assert.Equal(t, []error{errors.New("passwords do not match")}, confirmation.printedErrors)
+}
+
+func TestAsk_CrossValidateAnswerSource(t *testing.T) {
+	qs := []*Question{
+		{Name: "start", Prompt: &Input{Message: "Start port"}},
+		{
+			Name:   "end",
+			Prompt: &Input{Message: "End port"},
+			CrossValidate: func(ans interface{}, answers Answers) error {
+				if ans.(string) <= answers["start"].(string) {
+					return errors.New("the end port must be greater than the start port")
+				}
+				return nil
+			},
+		},
+	}
+
+	res := map[string]interface{}{}
+	err := Ask(qs, &res, WithAnswerSource(MapSource{"start": "8000", "end": "7000"}))
+	assert.EqualError(t, err, `invalid answer for "end": the end port must be greater than the start port`)
+}
@@ -0,0 +1,268 @@
+package survey
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// TableRow is a row of a table prompt the way it is shown by the template
+type TableRow struct {
+	// Index is the position of the row in Rows
+	Index int
+	Cells []string
+	// Line holds the cells aligned to the columns and cut to the width of the terminal
+	Line string
+}
+
+// table keeps track of the rows of a table prompt, how they are sorted and which one is focused
+type table struct {
+	headers []string
+	rows    [][]string
+	widths  []int
+	// sort is 0 when the rows are in their original order, otherwise the rows are sorted by
+	// column (sort-1)/2, in descending order when sort is even
+	sort  int
+	focus int
+}
+
+// newTable measures the columns so that every cell fits in its column
+func newTable(headers []string, rows [][]string) *table {
+	t := &table{headers: headers, rows: rows}
+
+	columns := len(headers)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	t.widths = make([]int, columns)
+	for i, header := range headers {
+		t.widths[i] = terminal.StringWidth(header)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if w := terminal.StringWidth(cell); w > t.widths[i] {
+				t.widths[i] = w
+			}
+		}
+	}
+	return t
+}
+
+// cell returns the cell of the row in the given column, rows can be shorter than the others
+func (t *table) cell(index int, column int) string {
+	if column < len(t.rows[index]) {
+		return t.rows[index][column]
+	}
+	return ""
+}
+
+// answer returns the answer for the row with the given index
+func (t *table) answer(index int, valueColumn int) core.OptionAnswer {
+	return core.OptionAnswer{Value: t.cell(index, valueColumn), Index: index}
+}
+
+// cycleSort moves through the ways to sort the rows: by every column ascending and descending
+// and then back to the original order
+func (t *table) cycleSort(delta int) {
+	ways := 2*len(t.widths) + 1
+	t.sort = (t.sort + delta + ways) % ways
+}
+
+// lessCell compares two cells as numbers if they both are, otherwise as text
+func lessCell(a string, b string) bool {
+	x, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return strings.ToLower(a) < strings.ToLower(b)
+}
+
+// visible returns the indices of the rows that have a cell matching the filter, sorted by the
+// chosen column
+func (t *table) visible(filter string, match func(filter string, value string, index int) bool) []int {
+	visible := []int{}
+	for i, row := range t.rows {
+		if filter == "" {
+			visible = append(visible, i)
+			continue
+		}
+		for _, cell := range row {
+			if match(filter, cell, i) {
+				visible = append(visible, i)
+				break
+			}
+		}
+	}
+
+	if t.sort > 0 {
+		column := (t.sort - 1) / 2
+		descending := t.sort%2 == 0
+		sort.SliceStable(visible, func(i, j int) bool {
+			a, b := t.cell(visible[i], column), t.cell(visible[j], column)
+			if descending {
+				return lessCell(b, a)
+			}
+			return lessCell(a, b)
+		})
+	}
+	return visible
+}
+
+// position returns where the focused row is in the visible rows, or the first one if the
+// focused row isn't visible.
+func (t *table) position(visible []int) int {
+	for pos, i := range visible {
+		if i == t.focus {
+			return pos
+		}
+	}
+	if len(visible) > 0 {
+		t.focus = visible[0]
+	}
+	return 0
+}
+
+// move focuses the row the given number of rows away, wrapping around at the ends
+func (t *table) move(visible []int, delta int) {
+	if len(visible) == 0 {
+		return
+	}
+	pos := (t.position(visible) + delta + len(visible)) % len(visible)
+	t.focus = visible[pos]
+}
+
+// truncate cuts the text so that it fits in the given width
+func truncate(text string, width int) string {
+	if terminal.StringWidth(text) <= width {
+		return text
+	}
+
+	cut := ""
+	for _, r := range text {
+		if terminal.StringWidth(cut+string(r)+"…") > width {
+			break
+		}
+		cut += string(r)
+	}
+	return cut + "…"
+}
+
+// line aligns the cells to the columns and cuts the line to the given width
+func (t *table) line(cells []string, width int) string {
+	return alignCells(cells, t.widths, width)
+}
+
+// alignCells pads every cell to the width of its column and cuts the line to the given width
+func alignCells(cells []string, widths []int, width int) string {
+	padded := []string{}
+	for i := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		padded = append(padded, cell+strings.Repeat(" ", widths[i]-terminal.StringWidth(cell)))
+	}
+	return truncate(strings.TrimRight(strings.Join(padded, "  "), " "), width)
+}
+
+// header returns the line with the headers, marking the column the rows are sorted by
+func (t *table) header(width int) string {
+	headers := make([]string, len(t.widths))
+	for i := range t.headers {
+		headers[i] = t.headers[i]
+	}
+	if t.sort > 0 {
+		column := (t.sort - 1) / 2
+		if t.sort%2 == 0 {
+			headers[column] += " ▼"
+		} else {
+			headers[column] += " ▲"
+		}
+	}
+
+	// make room for the marker
+	widths := append([]int{}, t.widths...)
+	for i, header := range headers {
+		if w := terminal.StringWidth(header); w > widths[i] {
+			widths[i] = w
+		}
+	}
+	return alignCells(headers, widths, width)
+}
+
+// page returns the rows of the page with the focused row and where the focused row is on it
+func (t *table) page(pageSize int, visible []int, width int) ([]TableRow, int) {
+	choices := []core.OptionAnswer{}
+	for pos, i := range visible {
+		choices = append(choices, core.OptionAnswer{Value: t.cell(i, 0), Index: pos})
+	}
+
+	opts, idx := paginate(pageSize, choices, t.position(visible))
+
+	page := []TableRow{}
+	for _, opt := range opts {
+		i := visible[opt.Index]
+		page = append(page, TableRow{Index: i, Cells: t.rows[i], Line: t.line(t.rows[i], width)})
+	}
+	return page, idx
+}
+
+// indexOf returns the index of the row described by a default or an answer. It accepts an
+// OptionAnswer, the index of the row or the value of the row in the value column.
+func (t *table) indexOf(value interface{}, valueColumn int) (int, error) {
+	switch v := value.(type) {
+	case core.OptionAnswer:
+		return t.indexOf(v.Index, valueColumn)
+	case int:
+		if v < 0 || v >= len(t.rows) {
+			return 0, fmt.Errorf("row %d is not in the table", v)
+		}
+		return v, nil
+	case string:
+		for i := range t.rows {
+			if t.cell(i, valueColumn) == v {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("%q is not in the table", v)
+	}
+	return 0, fmt.Errorf("cannot use %T as a row of a table", value)
+}
+
+// sourceRow returns the row given by an AnswerSource, either as a string like the ones typed
+// without a terminal or as the index of the row.
+func (t *table) sourceRow(value interface{}, valueColumn int) (int, error) {
+	if str, ok := value.(string); ok {
+		return t.parsePlain(str, valueColumn)
+	}
+	if index, ok := wholeNumber(value); ok {
+		return t.indexOf(index, valueColumn)
+	}
+	return 0, fmt.Errorf("cannot use %T as a row of a table", value)
+}
+
+// plainOptions lists every row of the table with the cells aligned
+func (t *table) plainOptions() []PlainOption {
+	options := []PlainOption{}
+	for i, row := range t.rows {
+		options = append(options, PlainOption{Number: i + 1, Value: t.line(row, 10000)})
+	}
+	return options
+}
+
+// parsePlain returns the row the user picked by typing either its number or its value
+func (t *table) parsePlain(input string, valueColumn int) (int, error) {
+	input = strings.TrimSpace(input)
+	if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(t.rows) {
+		return n - 1, nil
+	}
+	return t.indexOf(input, valueColumn)
+}
@@ -0,0 +1,288 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+TableSelect is a prompt that presents rows of cells under a line of headers for the user to
+select one of them using the arrow keys and enter. The left and right arrows sort the rows by
+each column in turn, and typing filters the rows whose cells match. Response type is a
+core.OptionAnswer with the cell of the row in ValueColumn and the index of the row in Rows.
+Default is either that cell or the index of the row.
+
+	instance := ""
+	prompt := &survey.TableSelect{
+		Message: "Choose an instance:",
+		Headers: []string{"NAME", "REGION", "SIZE"},
+		Rows: [][]string{
+			{"web-1", "us-east-1", "t3.small"},
+			{"db-1", "eu-west-1", "r5.large"},
+		},
+	}
+	survey.AskOne(prompt, &instance)
+*/
+type TableSelect struct {
+	Renderer
+	Message       string
+	Headers       []string
+	Rows          [][]string
+	ValueColumn   int
+	Default       interface{}
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	Filter        func(filter string, value string, index int) bool
+	table         *table
+	filter        string
+	showingHelp   bool
+}
+
+// TableSelectTemplateData is the data available to the templates when processing
+type TableSelectTemplateData struct {
+	TableSelect
+	Header        string
+	PageEntries   []TableRow
+	SelectedIndex int
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+	Config        *PromptConfig
+}
+
+var TableSelectQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, left and right to sort, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- if .Header}}{{"  "}}{{color "default+hb"}}{{ .Header }}{{color "reset"}}{{"\n"}}{{end}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- if eq $.SelectedIndex $ix }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }} {{else}}{{color "default"}}  {{end}}
+    {{- $row.Line}}
+    {{- color "reset"}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// match returns the filter used to find the rows the user is looking for
+func (s *TableSelect) match(config *PromptConfig) func(filter string, value string, index int) bool {
+	if s.Filter != nil {
+		return s.Filter
+	}
+	return config.Filter
+}
+
+// OnChange is called on every keypress.
+func (s *TableSelect) OnChange(key rune, config *PromptConfig) bool {
+	visible := s.table.visible(s.filter, s.match(config))
+
+	if key == terminal.KeyEnter || key == '\n' {
+		// we're done if there is a row to pick
+		return len(visible) > 0
+	} else if key == terminal.KeyArrowUp || (s.VimMode && key == 'k') {
+		s.table.move(visible, -1)
+	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (s.VimMode && key == 'j') {
+		s.table.move(visible, 1)
+	} else if key == terminal.KeyArrowRight || (s.VimMode && key == 'l') {
+		s.table.cycleSort(1)
+	} else if key == terminal.KeyArrowLeft || (s.VimMode && key == 'h') {
+		s.table.cycleSort(-1)
+	} else if string(key) == config.HelpInput && s.Help != "" {
+		s.showingHelp = true
+	} else if key == terminal.KeyEscape {
+		s.VimMode = !s.VimMode
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		s.filter = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if s.filter != "" {
+			runeFilter := []rune(s.filter)
+			s.filter = string(runeFilter[0 : len(runeFilter)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		s.filter += string(key)
+		s.VimMode = false
+	}
+
+	s.FilterMessage = ""
+	if s.filter != "" {
+		s.FilterMessage = " " + s.filter
+	}
+
+	_ = s.render(config)
+
+	// keep prompting
+	return false
+}
+
+// render shows the headers and the page of the rows with the focused one
+func (s *TableSelect) render(config *PromptConfig) error {
+	pageSize := s.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	// the rows start after the focus marker and stop before the edge of the terminal
+	width := s.termWidthSafe() - 3
+
+	visible := s.table.visible(s.filter, s.match(config))
+	rows, idx := s.table.page(pageSize, visible, width)
+
+	return s.Render(TableSelectQuestionTemplate, TableSelectTemplateData{
+		TableSelect:   *s,
+		Header:        s.table.header(width),
+		PageEntries:   rows,
+		SelectedIndex: idx,
+		ShowHelp:      s.showingHelp,
+		Config:        config,
+	})
+}
+
+// start builds the table and focuses the default row
+func (s *TableSelect) start() error {
+	if len(s.Rows) == 0 {
+		return errors.New("please provide rows to select from")
+	}
+
+	s.table = newTable(s.Headers, s.Rows)
+	if s.Default != nil {
+		index, err := s.table.indexOf(s.Default, s.ValueColumn)
+		if err != nil {
+			return fmt.Errorf("default value %w", err)
+		}
+		s.table.focus = index
+	}
+	return nil
+}
+
+func (s *TableSelect) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := s.start(); err != nil {
+		return core.OptionAnswer{}, err
+	}
+
+	if !s.interactive() {
+		return s.promptPlain(config)
+	}
+
+	cursor := s.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := s.render(config); err != nil {
+		return core.OptionAnswer{}, err
+	}
+
+	rr := s.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return core.OptionAnswer{}, err
+		}
+		if r == terminal.KeyInterrupt {
+			return core.OptionAnswer{}, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			s.filter = ""
+			s.FilterMessage = ""
+			return core.OptionAnswer{}, ErrGoBack
+		}
+		if r == terminal.KeyEndTransmission {
+			break
+		}
+		if s.OnChange(r, config) {
+			break
+		}
+	}
+
+	// make sure the focused row is one of the visible ones
+	s.table.position(s.table.visible(s.filter, s.match(config)))
+	s.filter = ""
+	s.FilterMessage = ""
+
+	return s.table.answer(s.table.focus, s.ValueColumn), nil
+}
+
+// promptPlain lists the numbered rows and reads a line with the number or the value of a row.
+func (s *TableSelect) promptPlain(config *PromptConfig) (interface{}, error) {
+	return s.askPlain(config, PlainTemplateData{
+		Message:      s.Message,
+		Help:         s.Help,
+		Options:      s.table.plainOptions(),
+		Instructions: "Enter a number or value",
+		Default:      s.table.cell(s.table.focus, s.ValueColumn),
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return s.table.answer(s.table.focus, s.ValueColumn), nil
+		}
+
+		index, err := s.table.parsePlain(line, s.ValueColumn)
+		if err != nil {
+			return nil, err
+		}
+		return s.table.answer(index, s.ValueColumn), nil
+	})
+}
+
+// parseAnswer picks the row given by an AnswerSource. A string is the number or the value of the
+// row like the answers typed without a terminal, and a number is the index of the row in Rows,
+// starting from 0 like the Index of the answer.
+func (s *TableSelect) parseAnswer(value interface{}) (interface{}, error) {
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+	index, err := s.table.sourceRow(value, s.ValueColumn)
+	if err != nil {
+		return nil, err
+	}
+	return s.table.answer(index, s.ValueColumn), nil
+}
+
+// defaultAnswer returns the row the cursor starts on.
+func (s *TableSelect) defaultAnswer() (interface{}, error) {
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+	return s.table.answer(s.table.focus, s.ValueColumn), nil
+}
+
+// SetDefault uses the given row as the default selection. It accepts an OptionAnswer, the
+// index of the row or its cell in ValueColumn.
+func (s *TableSelect) SetDefault(value interface{}) error {
+	if _, err := newTable(s.Headers, s.Rows).indexOf(value, s.ValueColumn); err != nil {
+		return err
+	}
+	if answer, ok := value.(core.OptionAnswer); ok {
+		value = answer.Index
+	}
+	s.Default = value
+	return nil
+}
+
+func (s *TableSelect) Cleanup(config *PromptConfig, val interface{}) error {
+	if !s.interactive() {
+		return nil
+	}
+	return s.Render(
+		TableSelectQuestionTemplate,
+		TableSelectTemplateData{
+			TableSelect: *s,
+			Answer:      val.(core.OptionAnswer).Value,
+			ShowAnswer:  true,
+			Config:      config,
+		},
+	)
+}
@@ -0,0 +1,303 @@
+package survey
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// instanceHeaders and instanceRows describe a few cloud instances to pick from
+var instanceHeaders = []string{"NAME", "REGION", "CPUS"}
+
+func instanceRows() [][]string {
+	return [][]string{
+		{"web-1", "us-east-1", "2"},
+		{"db-1", "eu-west-1", "16"},
+		{"worker-1", "us-west-2", "4"},
+	}
+}
+
+// tableLines returns how the visible rows are shown
+func tableLines(t *table, visible []int) []string {
+	lines := []string{}
+	for _, i := range visible {
+		lines = append(lines, t.line(t.rows[i], 80))
+	}
+	return lines
+}
+
+func TestTableVisible(t *testing.T) {
+	tb := newTable(instanceHeaders, instanceRows())
+
+	assert.Equal(t, []string{
+		"web-1     us-east-1  2",
+		"db-1      eu-west-1  16",
+		"worker-1  us-west-2  4",
+	}, tableLines(tb, tb.visible("", nil)))
+	assert.Equal(t, "NAME      REGION     CPUS", tb.header(80))
+
+	// the numbers are sorted by their value
+	tb.cycleSort(5)
+	assert.Equal(t, []int{0, 2, 1}, tb.visible("", nil))
+	assert.Equal(t, "NAME      REGION     CPUS ▲", tb.header(80))
+	tb.cycleSort(1)
+	assert.Equal(t, []int{1, 2, 0}, tb.visible("", nil))
+	assert.Equal(t, "NAME      REGION     CPUS ▼", tb.header(80))
+
+	// going past the last column goes back to the original order
+	tb.cycleSort(1)
+	assert.Equal(t, []int{0, 1, 2}, tb.visible("", nil))
+	tb.cycleSort(-1)
+	assert.Equal(t, []int{1, 2, 0}, tb.visible("", nil))
+
+	// the filter matches any of the cells
+	tb.cycleSort(-4)
+	assert.Equal(t, "NAME ▼    REGION     CPUS", tb.header(80))
+	assert.Equal(t, []int{2, 0}, tb.visible("us", defaultPromptConfig().Filter))
+	assert.Equal(t, []int{1}, tb.visible("16", defaultPromptConfig().Filter))
+}
+
+func TestTableLine(t *testing.T) {
+	tb := newTable([]string{"NAME", "NOTE"}, [][]string{{"世界", "wide"}, {"a", "a much longer note"}})
+
+	// wide characters take two columns
+	assert.Equal(t, "世界  wide", tb.line(tb.rows[0], 80))
+	assert.Equal(t, "a     a much longer note", tb.line(tb.rows[1], 80))
+
+	// lines are cut to the width of the terminal
+	assert.Equal(t, "a     a muc…", tb.line(tb.rows[1], 12))
+	assert.Equal(t, "世…", tb.line(tb.rows[0], 4))
+}
+
+func TestTableSelectRender(t *testing.T) {
+	prompt := TableSelect{
+		Message: "Choose an instance:",
+		Headers: instanceHeaders,
+		Rows:    instanceRows(),
+	}
+
+	tb := newTable(prompt.Headers, prompt.Rows)
+	rows, _ := tb.page(4, tb.visible("", nil), 80)
+
+	helpfulPrompt := prompt
+	helpfulPrompt.Help = "This is helpful"
+
+	tests := []struct {
+		title    string
+		prompt   TableSelect
+		data     TableSelectTemplateData
+		expected string
+	}{
+		{
+			"Test TableSelect question output",
+			prompt,
+			TableSelectTemplateData{SelectedIndex: 1, Header: tb.header(80), PageEntries: rows},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Choose an instance:  [Use arrows to move, left and right to sort, type to filter]", defaultIcons().Question.Text),
+					"  NAME      REGION     CPUS",
+					"  web-1     us-east-1  2",
+					fmt.Sprintf("%s db-1      eu-west-1  16", defaultIcons().SelectFocus.Text),
+					"  worker-1  us-west-2  4\n",
+				},
+				"\n",
+			),
+		},
+		{
+			"Test TableSelect answer output",
+			prompt,
+			TableSelectTemplateData{Answer: "db-1", ShowAnswer: true},
+			fmt.Sprintf("%s Choose an instance: db-1\n", defaultIcons().Question.Text),
+		},
+		{
+			"Test TableSelect question output with help hidden",
+			helpfulPrompt,
+			TableSelectTemplateData{PageEntries: rows[:1]},
+			fmt.Sprintf("%s Choose an instance:  [Use arrows to move, left and right to sort, type to filter, %s for more help]\n%s web-1     us-east-1  2\n", defaultIcons().Question.Text, defaultPromptConfig().HelpInput, defaultIcons().SelectFocus.Text),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.data.TableSelect = test.prompt
+
+			// set the icon set
+			test.data.Config = defaultPromptConfig()
+
+			err = test.prompt.Render(
+				TableSelectQuestionTemplate,
+				test.data,
+			)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestTableSelectPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"basic interaction",
+			&TableSelect{
+				Message: "Choose an instance:",
+				Headers: instanceHeaders,
+				Rows:    instanceRows(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an instance:")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "db-1", Index: 1},
+		},
+		{
+			"sort by a column",
+			&TableSelect{
+				Message: "Choose an instance:",
+				Headers: instanceHeaders,
+				Rows:    instanceRows(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an instance:")
+				// sort by the name
+				c.Send(string(terminal.KeyArrowRight))
+				c.ExpectString("NAME ▲")
+				// the focus stays on the same row
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "db-1", Index: 1},
+		},
+		{
+			"filter across the cells",
+			&TableSelect{
+				Message: "Choose an instance:",
+				Headers: instanceHeaders,
+				Rows:    instanceRows(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an instance:")
+				c.Send("us-")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "worker-1", Index: 2},
+		},
+		{
+			"value column and default",
+			&TableSelect{
+				Message:     "Choose a region:",
+				Headers:     instanceHeaders,
+				Rows:        instanceRows(),
+				ValueColumn: 1,
+				Default:     "us-west-2",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a region:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "us-west-2", Index: 2},
+		},
+		{
+			"prompt for help",
+			&TableSelect{
+				Message: "Choose an instance:",
+				Headers: instanceHeaders,
+				Rows:    instanceRows(),
+				Help:    "The instance to connect to",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose an instance:")
+				c.Send("?")
+				c.ExpectString("The instance to connect to")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.OptionAnswer{Value: "web-1", Index: 0},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestTableSelectSetDefault(t *testing.T) {
+	prompt := &TableSelect{Headers: instanceHeaders, Rows: instanceRows()}
+
+	assert.NoError(t, prompt.SetDefault("db-1"))
+	assert.Equal(t, "db-1", prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault(core.OptionAnswer{Value: "worker-1", Index: 2}))
+	assert.Equal(t, 2, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault("missing"))
+	assert.Error(t, prompt.SetDefault(3))
+	assert.Equal(t, 2, prompt.Default)
+}
+
+func TestTableSelect_WriteAnswer(t *testing.T) {
+	answers := struct {
+		Instance string
+		Region   string
+		Row      int
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "instance", Prompt: &TableSelect{Rows: instanceRows()}},
+		{Name: "region", Prompt: &TableSelect{Rows: instanceRows(), ValueColumn: 1}},
+		{Name: "row", Prompt: &TableSelect{Rows: instanceRows()}},
+	}, &answers, WithAnswerSource(MapSource{
+		"instance": "db-1",
+		"region":   "3",
+		"row":      1,
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "db-1", answers.Instance)
+	assert.Equal(t, "us-west-2", answers.Region)
+	assert.Equal(t, 1, answers.Row)
+}
+
+func TestTableSelect_SourceNumbers(t *testing.T) {
+	prompt := &TableSelect{Rows: instanceRows()}
+
+	// the numbers decoded from JSON are float64s
+	ans, err := prompt.parseAnswer(float64(2))
+	assert.NoError(t, err)
+	assert.Equal(t, core.OptionAnswer{Value: "worker-1", Index: 2}, ans)
+
+	ans, err = prompt.parseAnswer(int64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, core.OptionAnswer{Value: "db-1", Index: 1}, ans)
+
+	_, err = prompt.parseAnswer(1.5)
+	assert.Error(t, err)
+	_, err = prompt.parseAnswer(float64(3))
+	assert.Error(t, err)
+}
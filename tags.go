@@ -0,0 +1,354 @@
+package survey
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+Tags is a prompt for a list of values. The user types a value and presses enter or a comma to
+add it to the list, backspace on an empty line removes the last value and enter on an empty line
+finishes the prompt. Every value is checked with ValidateTag and values can't be added twice.
+Response type is a slice of strings. Default holds the values the list starts with.
+
+	hosts := []string{}
+	prompt := &survey.Tags{
+		Message: "Which hosts should be deployed to?",
+	}
+	survey.AskOne(prompt, &hosts)
+*/
+type Tags struct {
+	Renderer
+	Message       string
+	Default       []string
+	Help          string
+	Suggest       func(toComplete string) []string
+	ValidateTag   Validator
+	tags          []string
+	typed         string
+	options       []core.OptionAnswer
+	selectedIndex int
+	showingHelp   bool
+}
+
+// TagsTemplateData is the data available to the templates when processing
+type TagsTemplateData struct {
+	Tags
+	Values        []string
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+	PageEntries   []core.OptionAnswer
+	SelectedIndex int
+	Config        *PromptConfig
+}
+
+var TagsQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- range .Values}}{{color "cyan"}}[{{.}}]{{color "reset"}} {{end}}
+  {{- if .PageEntries -}}
+    {{- .Answer}} [Use arrows to move, enter to add, type to continue]
+    {{- "\n"}}
+    {{- range $ix, $choice := .PageEntries}}
+      {{- if eq $ix $.SelectedIndex }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }} {{else}}{{color "default"}}  {{end}}
+      {{- $choice.Value}}
+      {{- color "reset"}}{{"\n"}}
+    {{- end}}
+  {{- else }}
+    {{- color "cyan"}}[Enter or comma to add, enter on an empty line to finish
+    {{- if and .Help (not .ShowHelp)}}, {{ print .Config.HelpInput }} for help{{end}}
+    {{- if .Suggest}}, {{ print .Config.SuggestInput }} for suggestions{{end -}}
+    ]{{color "reset"}}{{" "}}
+  {{- end}}
+{{- end}}`
+
+// check returns an error if the tag can't be added to the given ones
+func (t *Tags) check(tags []string, tag string) error {
+	for _, existing := range tags {
+		if existing == tag {
+			return fmt.Errorf("%q was already added", tag)
+		}
+	}
+	if t.ValidateTag != nil {
+		return t.ValidateTag(tag)
+	}
+	return nil
+}
+
+// suggestions returns the suggestions for the typed text that haven't been added yet
+func (t *Tags) suggestions(toComplete string) []string {
+	suggestions := []string{}
+	for _, suggestion := range t.Suggest(toComplete) {
+		if t.check(t.tags, suggestion) == nil {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	return suggestions
+}
+
+// add adds the tag to the list, or shows why it can't be added and keeps it in the input
+func (t *Tags) add(config *PromptConfig, tag string) error {
+	if err := t.check(t.tags, tag); err != nil {
+		t.typed = tag
+		return t.Error(config, err)
+	}
+
+	t.tags = append(t.tags, tag)
+	t.typed = ""
+	// the tag is fine so remove any error shown for the previous one
+	t.clearRendered()
+	return nil
+}
+
+// render shows the tags that were added and the suggestions if there are any
+func (t *Tags) render(config *PromptConfig) error {
+	opts, idx := paginate(config.PageSize, t.options, t.selectedIndex)
+	return t.Render(TagsQuestionTemplate, TagsTemplateData{
+		Tags:          *t,
+		Values:        t.tags,
+		Answer:        t.typed,
+		ShowHelp:      t.showingHelp,
+		PageEntries:   opts,
+		SelectedIndex: idx,
+		Config:        config,
+	})
+}
+
+// readAgain shows the prompt again and tells the rune reader to start over with the typed text
+func (t *Tags) readAgain(config *PromptConfig, err error) ([]rune, bool, error) {
+	if err == nil {
+		err = t.render(config)
+	}
+	if err == nil {
+		err = errReadLineAgain
+	}
+
+	// the text typed while the suggestions are shown is part of the template
+	if t.options != nil {
+		return []rune{}, true, err
+	}
+	return []rune(t.typed), true, err
+}
+
+func (t *Tags) onRune(config *PromptConfig) terminal.OnRuneFn {
+	return terminal.OnRuneFn(func(key rune, line []rune) ([]rune, bool, error) {
+		if config.BackKey != 0 && key == config.BackKey {
+			t.options = nil
+			return line, true, ErrGoBack
+		}
+
+		if t.options != nil {
+			var err error
+			if key == terminal.KeyEnter || key == '\n' {
+				selected := t.options[t.selectedIndex].Value
+				t.options = nil
+				err = t.add(config, selected)
+			} else if key == terminal.KeyEscape {
+				t.options = nil
+			} else if key == terminal.KeyArrowUp {
+				t.selectedIndex = (t.selectedIndex - 1 + len(t.options)) % len(t.options)
+			} else if key == terminal.KeyArrowDown || key == terminal.KeyTab {
+				t.selectedIndex = (t.selectedIndex + 1) % len(t.options)
+			} else {
+				if terminal.IsTyped(key) {
+					t.typed += string(key)
+				}
+				t.options = nil
+			}
+			return t.readAgain(config, err)
+		}
+
+		text := strings.TrimSpace(string(line))
+		if key == ',' || ((key == terminal.KeyEnter || key == '\n') && text != "") {
+			if text == "" {
+				// there is nothing to add
+				t.typed = string(line)
+				return t.readAgain(config, nil)
+			}
+			if text == config.HelpInput && t.Help != "" && key != ',' {
+				t.showingHelp = true
+				t.typed = ""
+				return t.readAgain(config, nil)
+			}
+			return t.readAgain(config, t.add(config, text))
+		}
+
+		if (key == terminal.KeyBackspace || key == terminal.KeyDelete) && len(line) == 0 && len(t.tags) > 0 {
+			t.tags = t.tags[:len(t.tags)-1]
+			t.typed = ""
+			return t.readAgain(config, nil)
+		}
+
+		if key == terminal.KeyTab && t.Suggest != nil {
+			options := t.suggestions(string(line))
+			if len(options) == 0 {
+				return line, false, nil
+			}
+
+			t.selectedIndex = 0
+			if len(options) == 1 {
+				t.typed = options[0]
+			} else {
+				t.typed = string(line)
+				t.options = core.OptionAnswerList(options)
+			}
+			return t.readAgain(config, nil)
+		}
+
+		return line, false, nil
+	})
+}
+
+func (t *Tags) Prompt(config *PromptConfig) (interface{}, error) {
+	t.tags = append([]string{}, t.Default...)
+	t.typed = ""
+	t.options = nil
+
+	if !t.interactive() {
+		return t.promptPlain(config)
+	}
+
+	if err := t.render(config); err != nil {
+		return nil, err
+	}
+
+	// start reading runes from the standard in
+	rr := t.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+	cursor := t.NewCursor()
+	if !config.ShowCursor {
+		cursor.Hide()       // hide the cursor
+		defer cursor.Show() // show the cursor when we're done
+	}
+
+	var line []rune
+	for {
+		var err error
+		line, err = rr.ReadLineWithDefault(0, line, t.onRune(config))
+		if err == errReadLineAgain {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	// readline print an empty line, go up before we render the follow up
+	cursor.Up(1)
+
+	return append([]string{}, t.tags...), nil
+}
+
+// promptPlain reads a line with the values separated by commas.
+func (t *Tags) promptPlain(config *PromptConfig) (interface{}, error) {
+	return t.askPlain(config, PlainTemplateData{
+		Message:      t.Message,
+		Help:         t.Help,
+		Instructions: "Enter values separated by commas",
+		Default:      strings.Join(t.Default, ", "),
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return append([]string{}, t.Default...), nil
+		}
+		return t.split(line)
+	})
+}
+
+// parseAnswer checks the values given by an AnswerSource, either as a list or separated by
+// commas.
+func (t *Tags) parseAnswer(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return t.split(v)
+	case []string:
+		return t.parse(v)
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot use %T as the answer to tags", item)
+			}
+			values = append(values, str)
+		}
+		return t.parse(values)
+	}
+	return nil, fmt.Errorf("cannot use %T as the answer to tags", value)
+}
+
+// defaultAnswer returns the default values if they pass the checks of the prompt.
+func (t *Tags) defaultAnswer() (interface{}, error) {
+	return t.parse(t.Default)
+}
+
+// split returns the values of a line separated by commas, checking every one of them
+func (t *Tags) split(line string) ([]string, error) {
+	values := []string{}
+	for _, part := range strings.Split(line, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return t.parse(values)
+}
+
+// parse checks the given values the same way as the ones the user adds
+func (t *Tags) parse(values []string) ([]string, error) {
+	tags := []string{}
+	for _, value := range values {
+		if err := t.check(tags, value); err != nil {
+			return nil, err
+		}
+		tags = append(tags, value)
+	}
+	return tags, nil
+}
+
+// SetDefault uses the given values as the ones the list starts with.
+func (t *Tags) SetDefault(value interface{}) error {
+	var values []string
+	switch v := value.(type) {
+	case []string:
+		values = v
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+	default:
+		return fmt.Errorf("cannot use %T as the default of tags", value)
+	}
+
+	tags, err := t.parse(values)
+	if err != nil {
+		return err
+	}
+	t.Default = tags
+	return nil
+}
+
+func (t *Tags) Cleanup(config *PromptConfig, val interface{}) error {
+	if !t.interactive() {
+		return nil
+	}
+	return t.Render(
+		TagsQuestionTemplate,
+		TagsTemplateData{
+			Tags:       *t,
+			Answer:     strings.Join(val.([]string), ", "),
+			ShowAnswer: true,
+			Config:     config,
+		},
+	)
+}
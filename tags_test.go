@@ -0,0 +1,252 @@
+package survey
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestTagsRender(t *testing.T) {
+	prompt := Tags{
+		Message: "Which hosts?",
+	}
+
+	helpfulPrompt := prompt
+	helpfulPrompt.Help = "This is helpful"
+
+	suggestPrompt := prompt
+	suggestPrompt.Suggest = func(string) []string { return nil }
+
+	tests := []struct {
+		title    string
+		prompt   Tags
+		data     TagsTemplateData
+		expected string
+	}{
+		{
+			"Test Tags question output",
+			prompt,
+			TagsTemplateData{},
+			fmt.Sprintf("%s Which hosts? [Enter or comma to add, enter on an empty line to finish] ", defaultIcons().Question.Text),
+		},
+		{
+			"Test Tags question output with tags",
+			helpfulPrompt,
+			TagsTemplateData{Values: []string{"web-1", "web-2"}},
+			fmt.Sprintf("%s Which hosts? [web-1] [web-2] [Enter or comma to add, enter on an empty line to finish, %s for help] ", defaultIcons().Question.Text, defaultPromptConfig().HelpInput),
+		},
+		{
+			"Test Tags question output with suggestions",
+			suggestPrompt,
+			TagsTemplateData{},
+			fmt.Sprintf("%s Which hosts? [Enter or comma to add, enter on an empty line to finish, %s for suggestions] ", defaultIcons().Question.Text, defaultPromptConfig().SuggestInput),
+		},
+		{
+			"Test Tags suggestion list",
+			suggestPrompt,
+			TagsTemplateData{
+				Values:        []string{"db"},
+				Answer:        "we",
+				PageEntries:   core.OptionAnswerList([]string{"web-1", "web-2"}),
+				SelectedIndex: 1,
+			},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Which hosts? [db] we [Use arrows to move, enter to add, type to continue]", defaultIcons().Question.Text),
+					"  web-1",
+					fmt.Sprintf("%s web-2\n", defaultIcons().SelectFocus.Text),
+				},
+				"\n",
+			),
+		},
+		{
+			"Test Tags answer output",
+			prompt,
+			TagsTemplateData{Answer: "web-1, web-2", ShowAnswer: true},
+			fmt.Sprintf("%s Which hosts? web-1, web-2\n", defaultIcons().Question.Text),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.data.Tags = test.prompt
+
+			// set the icon set
+			test.data.Config = defaultPromptConfig()
+
+			err = test.prompt.Render(
+				TagsQuestionTemplate,
+				test.data,
+			)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestTagsPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"add tags with enter and comma",
+			&Tags{
+				Message: "Which hosts?",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which hosts?")
+				c.SendLine("web-1")
+				c.ExpectString("[web-1]")
+				c.Send("web-2, db")
+				c.ExpectString("[web-2]")
+				c.SendLine("")
+				c.ExpectString("[db]")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]string{"web-1", "web-2", "db"},
+		},
+		{
+			"backspace removes the last tag",
+			&Tags{
+				Message: "Which hosts?",
+				Default: []string{"web-1", "web-2"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("[web-2]")
+				c.Send(string(terminal.KeyBackspace))
+				c.SendLine("db")
+				c.ExpectString("[db]")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]string{"web-1", "db"},
+		},
+		{
+			"duplicates and invalid tags are rejected",
+			&Tags{
+				Message: "Which hosts?",
+				Default: []string{"web-1"},
+				ValidateTag: func(val interface{}) error {
+					if strings.Contains(val.(string), " ") {
+						return errors.New("hosts can't contain spaces")
+					}
+					return nil
+				},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which hosts?")
+				c.SendLine("web-1")
+				c.ExpectString(`"web-1" was already added`)
+				// the rejected tag is kept so it can be fixed
+				c.Send(string(terminal.KeyBackspace))
+				c.SendLine("2")
+				c.ExpectString("[web-2]")
+				c.SendLine("my host")
+				c.ExpectString("hosts can't contain spaces")
+				c.SendLine("")
+				c.ExpectString("hosts can't contain spaces")
+				c.Send(strings.Repeat(string(terminal.KeyBackspace), len("my host")))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]string{"web-1", "web-2"},
+		},
+		{
+			"suggestions",
+			&Tags{
+				Message: "Which hosts?",
+				Suggest: func(toComplete string) []string {
+					return []string{"web-1", "web-2", "db"}
+				},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which hosts?")
+				c.Send(string(terminal.KeyTab))
+				c.ExpectString("web-2")
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectString("[web-2]")
+				// the tags that were added aren't suggested again
+				c.Send(string(terminal.KeyTab))
+				c.SendLine("")
+				c.ExpectString("[web-1]")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]string{"web-2", "web-1"},
+		},
+		{
+			"prompt for help",
+			&Tags{
+				Message: "Which hosts?",
+				Help:    "The hosts to deploy to",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Which hosts?")
+				c.SendLine("?")
+				c.ExpectString("The hosts to deploy to")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			[]string{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestTagsSetDefault(t *testing.T) {
+	prompt := &Tags{}
+
+	assert.NoError(t, prompt.SetDefault([]string{"web-1", "web-2"}))
+	assert.Equal(t, []string{"web-1", "web-2"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault([]interface{}{"db"}))
+	assert.Equal(t, []string{"db"}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault([]string{"db", "db"}))
+	assert.Error(t, prompt.SetDefault("db"))
+	assert.Equal(t, []string{"db"}, prompt.Default)
+}
+
+func TestTags_WriteAnswer(t *testing.T) {
+	answers := struct {
+		Hosts []string
+		Ports []int
+	}{}
+
+	err := Ask([]*Question{
+		{Name: "hosts", Prompt: &Tags{Message: "Which hosts?"}},
+		{Name: "ports", Prompt: &Tags{Message: "Which ports?"}},
+	}, &answers, WithAnswerSource(MapSource{
+		"hosts": "web-1, web-2",
+		"ports": []interface{}{"80", "443"},
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"web-1", "web-2"}, answers.Hosts)
+	assert.Equal(t, []int{80, 443}, answers.Ports)
+}
@@ -0,0 +1,49 @@
+package terminal
+
+import (
+	"context"
+	"time"
+)
+
+// how often a context reader checks if its context is done while waiting for input
+const contextPollInterval = 50 * time.Millisecond
+
+type contextReader struct {
+	ctx context.Context
+	in  FileReader
+}
+
+// NewContextReader returns a FileReader that reads from in until ctx is done. A read
+// that is waiting for input returns ctx.Err() as soon as the context is cancelled or
+// its deadline passes, instead of blocking until the user presses a key.
+func NewContextReader(ctx context.Context, in FileReader) FileReader {
+	return &contextReader{ctx: ctx, in: in}
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.wait(); err != nil {
+		return 0, err
+	}
+	return r.in.Read(p)
+}
+
+func (r *contextReader) Fd() uintptr {
+	return r.in.Fd()
+}
+
+// wait blocks until there is input to read or the context is done.
+func (r *contextReader) wait() error {
+	for {
+		if err := r.ctx.Err(); err != nil {
+			return err
+		}
+
+		ready, err := waitForInput(r.in.Fd(), contextPollInterval)
+		if err != nil {
+			return err
+		}
+		if ready {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+//go:build !windows
+// +build !windows
+
+package terminal
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestContextReaderReads(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to open pipe: %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if _, err := w.Write([]byte("a")); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+
+	buf := make([]byte, 1)
+	n, err := NewContextReader(context.Background(), r).Read(buf)
+	if err != nil || n != 1 || buf[0] != 'a' {
+		t.Errorf("Read() = %d, %v with %q, want 1, nil with %q", n, err, buf, "a")
+	}
+}
+
+func TestContextReaderCancel(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to open pipe: %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = NewContextReader(ctx, r).Read(make([]byte, 1))
+	if err != context.DeadlineExceeded {
+		t.Errorf("Read() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
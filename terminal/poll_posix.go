@@ -0,0 +1,27 @@
+//go:build !windows
+// +build !windows
+
+package terminal
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// waitForInput waits up to timeout for the file descriptor to have input to read.
+func waitForInput(fd uintptr, timeout time.Duration) (bool, error) {
+	fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+
+	n, err := unix.Poll(fds, int(timeout/time.Millisecond))
+	if err == unix.EINTR {
+		// we were interrupted by a signal before anything happened
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// hangups and invalid descriptors are left for the read to report
+	return n > 0, nil
+}
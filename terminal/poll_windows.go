@@ -0,0 +1,15 @@
+package terminal
+
+import (
+	"syscall"
+	"time"
+)
+
+// waitForInput waits up to timeout for the console handle to have input to read.
+func waitForInput(fd uintptr, timeout time.Duration) (bool, error) {
+	event, err := syscall.WaitForSingleObject(syscall.Handle(fd), uint32(timeout/time.Millisecond))
+	if err != nil {
+		return false, err
+	}
+	return event == syscall.WAIT_OBJECT_0, nil
+}
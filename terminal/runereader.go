@@ -311,8 +311,8 @@ func (rr *RuneReader) ReadLineWithDefault(mask rune, d []rune, onRunes ...OnRune
 			continue
 		}
 
-		// if the letter is another escape sequence
-		if unicode.IsControl(r) || r == IgnoreKey {
+		// if the letter is another escape sequence or a key that doesn't type anything
+		if !IsTyped(r) {
 			// ignore it
 			continue
 		}
@@ -124,6 +124,18 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 			_, _ = rr.state.reader.Discard(1)
 			return SpecialKeyDelete, 1, nil
 		}
+	case '5': // ESC [ 5
+		if keypad == normalKeypad {
+			// discard the following '~' key from buffer
+			_, _ = rr.state.reader.Discard(1)
+			return SpecialKeyPageUp, 1, nil
+		}
+	case '6': // ESC [ 6
+		if keypad == normalKeypad {
+			// discard the following '~' key from buffer
+			_, _ = rr.state.reader.Discard(1)
+			return SpecialKeyPageDown, 1, nil
+		}
 	}
 
 	// discard the following '~' key from buffer
@@ -0,0 +1,30 @@
+//go:build !windows
+// +build !windows
+
+package terminal
+
+import (
+	"os"
+	"testing"
+)
+
+func TestRuneReaderSpecialKeys(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to open pipe: %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if _, err := w.Write([]byte("\x1b[5~\x1b[6~\x1b[H\x1bOF\x1b[3~a")); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+
+	rr := NewRuneReader(Stdio{In: r})
+	for _, expected := range []rune{SpecialKeyPageUp, SpecialKeyPageDown, SpecialKeyHome, SpecialKeyEnd, SpecialKeyDelete, 'a'} {
+		key, _, err := rr.ReadRune()
+		if err != nil || key != expected {
+			t.Errorf("ReadRune() = %q, %v, want %q", key, err, expected)
+		}
+	}
+}
@@ -64,3 +64,25 @@ func TestStringWidthFormat(t *testing.T) {
 		t.Errorf("Expected '%s' to have width %d, found %d", example, expected, actual)
 	}
 }
+
+func TestIsTyped(t *testing.T) {
+	for _, key := range []rune{'a', ' ', '错'} {
+		if !IsTyped(key) {
+			t.Errorf("Expected '%c' to be typed", key)
+		}
+	}
+	for _, key := range []rune{SpecialKeyPageUp, SpecialKeyPageDown, KeyDelete, KeyEscape, '\x13'} {
+		if IsTyped(key) {
+			t.Errorf("Expected %q not to be typed", key)
+		}
+	}
+}
+
+func TestPageKeysAreNotControlKeys(t *testing.T) {
+	// Ctrl+@ to Ctrl+_ send the codes before the space
+	for _, key := range []rune{SpecialKeyPageUp, SpecialKeyPageDown} {
+		if key < KeySpace {
+			t.Errorf("Expected %q not to be sent by a control key", key)
+		}
+	}
+}
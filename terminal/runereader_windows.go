@@ -19,6 +19,8 @@ const (
 	// key codes for arrow keys
 	// https://msdn.microsoft.com/en-us/library/windows/desktop/dd375731(v=vs.85).aspx
 	VK_DELETE = 0x2E
+	VK_PRIOR  = 0x21
+	VK_NEXT   = 0x22
 	VK_END    = 0x23
 	VK_HOME   = 0x24
 	VK_LEFT   = 0x25
@@ -91,6 +93,13 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 	ir := &inputRecord{}
 	bytesRead := 0
 	for {
+		// don't block on the console if we were given a context to stop for
+		if cr, ok := rr.stdio.In.(*contextReader); ok {
+			if err := cr.wait(); err != nil {
+				return 0, 0, err
+			}
+		}
+
 		rv, _, e := readConsoleInput.Call(rr.stdio.In.Fd(), uintptr(unsafe.Pointer(ir)), 1, uintptr(unsafe.Pointer(&bytesRead)))
 		// windows returns non-zero to indicate success
 		if rv == 0 && e != nil {
@@ -130,6 +139,10 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 				return SpecialKeyHome, bytesRead, nil
 			case VK_END:
 				return SpecialKeyEnd, bytesRead, nil
+			case VK_PRIOR:
+				return SpecialKeyPageUp, bytesRead, nil
+			case VK_NEXT:
+				return SpecialKeyPageDown, bytesRead, nil
 			default:
 				// not a virtual key that we care about so just continue on to
 				// the next input key
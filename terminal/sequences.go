@@ -3,6 +3,7 @@ package terminal
 import (
 	"fmt"
 	"io"
+	"unicode"
 )
 
 const (
@@ -26,6 +27,18 @@ const (
 	KeyTab             = '\t'
 )
 
+// the page keys come from the private use area so they can't be mistaken for a control key
+const (
+	SpecialKeyPageUp   = '\uF72C'
+	SpecialKeyPageDown = '\uF72D'
+)
+
+// IsTyped returns whether the key types a character, rather than being a control key or one of
+// the page keys.
+func IsTyped(key rune) bool {
+	return !unicode.IsControl(key) && key != SpecialKeyPageUp && key != SpecialKeyPageDown
+}
+
 func soundBell(out io.Writer) error {
 	_, err := fmt.Fprint(out, "\a")
 	return err
@@ -0,0 +1,57 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var path = []string{}
+var zone = ""
+
+var regions = map[string][]string{
+	"France":  {"Brittany", "Normandy"},
+	"Germany": {"Bavaria", "Berlin", "Hesse"},
+}
+
+var zones = map[string][]string{
+	"Brittany": {"brest-a"},
+	"Normandy": {"caen-a", "caen-b"},
+	"Bavaria":  {"munich-a", "munich-b"},
+	"Berlin":   {"berlin-a"},
+	"Hesse":    {"frankfurt-a", "frankfurt-b", "frankfurt-c"},
+}
+
+var levels = []survey.CascadeLevel{
+	{Name: "Country", Options: func([]string) []string { return []string{"France", "Germany", "Monaco"} }},
+	{Name: "Region", Options: func(path []string) []string { return regions[path[0]] }},
+	{Name: "Zone", Options: func(path []string) []string { return zones[path[1]] }},
+}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"standard (Monaco has no regions)", &survey.Cascade{
+			Message: "zone:",
+			Levels:  levels,
+		}, &path, nil,
+	},
+	{
+		"default", &survey.Cascade{
+			Message: "zone:",
+			Levels:  levels,
+			Default: []string{"Germany", "Hesse", "frankfurt-b"},
+		}, &path, nil,
+	},
+	{
+		"last value only", &survey.Cascade{
+			Message:  "zone:",
+			Levels:   levels,
+			PageSize: 2,
+		}, &zone, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,48 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var env = ""
+var envs = []string{}
+var envID = 0
+
+var environments = []survey.Option{
+	{Value: "dev", Label: "Development", Group: "Internal"},
+	{Value: "staging", Label: "Staging", Group: "Internal"},
+	{Value: "prod", Label: "Production", Group: "Public", Disabled: true, DisabledReason: "no permission"},
+	{Value: "demo", Label: "Demo", Group: "Public"},
+}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"select (the cursor skips Public and Production)", &survey.Select{
+			Message: "Choose an environment:",
+			Choices: environments,
+		}, &env, nil,
+	},
+	{
+		"multiselect (Production stays checked)", &survey.MultiSelect{
+			Message: "Deploy to:",
+			Choices: environments,
+			Default: []string{"prod"},
+		}, &envs, nil,
+	},
+	{
+		"select with data (answers with the id of the environment)", &survey.Select{
+			Message: "Choose an environment:",
+			Choices: []survey.Option{
+				{Value: "dev", Label: "Development", Data: 12},
+				{Value: "prod", Label: "Production", Data: 7},
+			},
+		}, &envID, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,48 @@
+//go:build ignore
+
+package main
+
+import (
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var when = time.Time{}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"pick today", &survey.DateTime{
+			Message: "date:",
+		}, &when, nil,
+	},
+	{
+		"move around and change months", &survey.DateTime{
+			Message: "date:",
+		}, &when, nil,
+	},
+	{
+		"type a date", &survey.DateTime{
+			Message: "date:",
+		}, &when, nil,
+	},
+	{
+		"only the next two weeks", &survey.DateTime{
+			Message: "date:",
+			Min:     time.Now(),
+			Max:     time.Now().AddDate(0, 0, 14),
+		}, &when, nil,
+	},
+	{
+		"date and time starting on monday", &survey.DateTime{
+			Message:      "date:",
+			PickTime:     true,
+			FirstWeekday: time.Monday,
+		}, &when, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,47 @@
+//go:build ignore
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func main() {
+	fmt.Println("Asking with numbered options.")
+	color := ""
+	err := survey.AskOne(&survey.Select{
+		Message: "Choose a color:",
+		Options: []string{"red", "blue", "green"},
+	}, &color, survey.WithHotkeys())
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Printf("Answered with %v.\n", color)
+
+	fmt.Println("Asking with keys of their own (y, n, 1 for later).")
+	deploy := ""
+	err = survey.AskOne(&survey.Select{
+		Message: "Deploy now?",
+		Choices: []survey.Option{{Value: "yes", Key: 'y'}, {Value: "no", Key: 'n'}, {Value: "later"}},
+	}, &deploy, survey.WithHotkeys())
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Printf("Answered with %v.\n", deploy)
+
+	fmt.Println("Asking for many with keys that check and uncheck the options.")
+	days := []string{}
+	err = survey.AskOne(&survey.MultiSelect{
+		Message: "What days do you prefer:",
+		Options: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+	}, &days, survey.WithHotkeys())
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Printf("Answered with %v.\n", days)
+}
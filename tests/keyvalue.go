@@ -0,0 +1,47 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var env = map[string]string{}
+var ports = map[string]int{}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"standard", &survey.KeyValue{
+			Message: "environment:",
+		}, &env, nil,
+	},
+	{
+		"default (starts with PORT and DEBUG)", &survey.KeyValue{
+			Message: "environment:",
+			Default: map[string]string{"PORT": "8080", "DEBUG": "false"},
+		}, &env, nil,
+	},
+	{
+		"validated (keys of at most 5 characters)", &survey.KeyValue{
+			Message:     "environment:",
+			ValidateKey: survey.MaxLength(5),
+		}, &env, nil,
+	},
+	{
+		"paginated", &survey.KeyValue{
+			Message:  "environment:",
+			Default:  map[string]string{"A": "1", "B": "2", "C": "3", "D": "4", "E": "5", "F": "6"},
+			PageSize: 3,
+		}, &env, nil,
+	},
+	{
+		"ints", &survey.KeyValue{
+			Message: "ports:",
+		}, &ports, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,71 @@
+//go:build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var answer = ""
+var answers = []string{}
+
+// slowRefs pretends to search a large repository for the refs that contain the filter
+func slowRefs(ctx context.Context, filter string) ([]string, error) {
+	select {
+	case <-time.After(time.Second):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	refs := []string{}
+	for i := 0; i < 2000; i++ {
+		ref := fmt.Sprintf("refs/heads/feature-%d", i)
+		if strings.Contains(ref, filter) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs, nil
+}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"loads for a second (type to load again)", &survey.Select{
+			Message:     "Choose a ref:",
+			LoadOptions: slowRefs,
+		}, &answer, nil,
+	},
+	{
+		"default shown while loading", &survey.Select{
+			Message:     "Choose a ref:",
+			LoadOptions: slowRefs,
+			Default:     "refs/heads/feature-42",
+		}, &answer, nil,
+	},
+	{
+		"multi (checked refs stay while filtering)", &survey.MultiSelect{
+			Message:     "Choose refs:",
+			LoadOptions: slowRefs,
+		}, &answers, nil,
+	},
+	{
+		"failing load", &survey.Select{
+			Message: "Choose a ref:",
+			LoadOptions: func(ctx context.Context, filter string) ([]string, error) {
+				if filter == "" {
+					return nil, fmt.Errorf("type something to search")
+				}
+				return slowRefs(ctx, filter)
+			},
+		}, &answer, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,64 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var count = 0
+var ratio = 0.0
+var min, max = 1.0, 5.0
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"Enter a number", &survey.Number{
+			Message: "number:",
+		}, &count, nil,
+	},
+	{
+		"default", &survey.Number{
+			Message: "number:",
+			Default: 3,
+		}, &count, nil,
+	},
+	{
+		"letters are ignored (type a few letters then a number)", &survey.Number{
+			Message: "number:",
+		}, &count, nil,
+	},
+	{
+		"use the arrows (stops at 1 and 5)", &survey.Number{
+			Message: "number:",
+			Default: 3,
+			Min:     &min,
+			Max:     &max,
+		}, &count, nil,
+	},
+	{
+		"out of range (enter 6 then 5)", &survey.Number{
+			Message: "number:",
+			Min:     &min,
+			Max:     &max,
+		}, &count, nil,
+	},
+	{
+		"at least 1 (enter -5 then 8)", &survey.Number{
+			Message: "number:",
+			Min:     &min,
+		}, &count, nil,
+	},
+	{
+		"float with a step of 0.1", &survey.Number{
+			Message: "ratio:",
+			Default: 0.5,
+			Step:    0.1,
+			Float:   true,
+		}, &ratio, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
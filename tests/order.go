@@ -0,0 +1,44 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var priorities = []string{}
+var indices = []int{}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"standard", &survey.Order{
+			Message: "priorities:",
+			Options: []string{"search", "export", "dark mode", "sharing"},
+		}, &priorities, nil,
+	},
+	{
+		"default (dark mode starts at the top)", &survey.Order{
+			Message: "priorities:",
+			Options: []string{"search", "export", "dark mode", "sharing"},
+			Default: []string{"dark mode"},
+		}, &priorities, nil,
+	},
+	{
+		"paginated (move an option past the second page)", &survey.Order{
+			Message:  "priorities:",
+			Options:  []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"},
+			PageSize: 4,
+		}, &priorities, nil,
+	},
+	{
+		"indices", &survey.Order{
+			Message: "priorities:",
+			Options: []string{"search", "export", "dark mode", "sharing"},
+		}, &indices, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
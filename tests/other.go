@@ -0,0 +1,41 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var answer = survey.OptionAnswer{}
+var color = ""
+var hobbies = []string{}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"select (choose Other... and type a color)", &survey.Select{
+			Message: "Choose a color:",
+			Options: []string{"red", "blue", "green"},
+			Other:   "Other...",
+		}, &answer, nil,
+	},
+	{
+		"select validated (at most 5 characters, esc goes back)", &survey.Select{
+			Message:       "Choose a color:",
+			Options:       []string{"red", "blue", "green"},
+			Other:         "Other...",
+			ValidateOther: survey.MaxLength(5),
+		}, &color, nil,
+	},
+	{
+		"multiselect", &survey.MultiSelect{
+			Message: "Hobbies:",
+			Options: []string{"chess", "reading", "cooking"},
+			Other:   "Other:",
+		}, &hobbies, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,50 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var path = ""
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"type a path", &survey.Path{
+			Message: "path:",
+		}, &path, nil,
+	},
+	{
+		"press tab to list the current directory", &survey.Path{
+			Message: "path:",
+		}, &path, nil,
+	},
+	{
+		"directories only (tab only lists directories)", &survey.Path{
+			Message:  "directory:",
+			DirsOnly: true,
+		}, &path, nil,
+	},
+	{
+		"go files only (enter README.md then a go file)", &survey.Path{
+			Message:    "go file:",
+			Extensions: []string{".go"},
+		}, &path, nil,
+	},
+	{
+		"must exist (enter a missing file then an existing one)", &survey.Path{
+			Message:   "existing path:",
+			MustExist: true,
+		}, &path, nil,
+	},
+	{
+		"home directory (type ~/ then press tab)", &survey.Path{
+			Message: "path:",
+		}, &path, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
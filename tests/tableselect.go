@@ -0,0 +1,60 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var instance = ""
+var row = 0
+var instances = []string{}
+
+var headers = []string{"NAME", "REGION", "CPUS", "DESCRIPTION"}
+
+var rows = [][]string{
+	{"web-1", "us-east-1", "2", "serves the public website and the marketing pages"},
+	{"db-1", "eu-west-1", "16", "primary database"},
+	{"worker-1", "us-west-2", "4", "runs the background jobs"},
+	{"cache-1", "ap-south-1", "8", "在线缓存"},
+}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"standard (sort with left and right)", &survey.TableSelect{
+			Message: "instance:",
+			Headers: headers,
+			Rows:    rows,
+		}, &instance, nil,
+	},
+	{
+		"default and value column (region)", &survey.TableSelect{
+			Message:     "region:",
+			Headers:     headers,
+			Rows:        rows,
+			ValueColumn: 1,
+			Default:     "us-west-2",
+		}, &instance, nil,
+	},
+	{
+		"row index", &survey.TableSelect{
+			Message:  "instance:",
+			Headers:  headers,
+			Rows:     rows,
+			PageSize: 2,
+		}, &row, nil,
+	},
+	{
+		"multi", &survey.MultiTableSelect{
+			Message: "instances:",
+			Headers: headers,
+			Rows:    rows,
+			Default: []string{"db-1"},
+		}, &instances, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
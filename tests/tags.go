@@ -0,0 +1,48 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var hosts = []string{}
+var ports = []int{}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"standard", &survey.Tags{
+			Message: "hosts:",
+		}, &hosts, nil,
+	},
+	{
+		"default (starts with web-1 and web-2)", &survey.Tags{
+			Message: "hosts:",
+			Default: []string{"web-1", "web-2"},
+		}, &hosts, nil,
+	},
+	{
+		"suggestions (press tab)", &survey.Tags{
+			Message: "hosts:",
+			Suggest: func(toComplete string) []string {
+				return []string{"web-1", "web-2", "worker-1", "db"}
+			},
+		}, &hosts, nil,
+	},
+	{
+		"validated (at most 5 characters each)", &survey.Tags{
+			Message:     "hosts:",
+			ValidateTag: survey.MaxLength(5),
+		}, &hosts, nil,
+	},
+	{
+		"ints", &survey.Tags{
+			Message: "ports:",
+		}, &ports, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
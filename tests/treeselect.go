@@ -0,0 +1,63 @@
+//go:build ignore
+
+package main
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	TestUtil "github.com/AlecAivazis/survey/v2/tests/util"
+)
+
+var resource = survey.TreeAnswer{}
+var resources = []survey.TreeAnswer{}
+
+func options() []survey.TreeNode {
+	return []survey.TreeNode{
+		{Value: "default", Children: []survey.TreeNode{
+			{Value: "deployments", Children: []survey.TreeNode{{Value: "web"}, {Value: "worker"}}},
+			{Value: "services", Children: []survey.TreeNode{{Value: "web"}}},
+		}},
+		{Value: "kube-system", Expanded: true, Children: []survey.TreeNode{
+			{Value: "pods", Children: []survey.TreeNode{{Value: "coredns"}, {Value: "etcd"}, {Value: "kube-proxy"}}},
+			{Value: "configmaps", Children: []survey.TreeNode{{Value: "coredns"}, {Value: "kube-proxy"}}},
+		}},
+	}
+}
+
+var goodTable = []TestUtil.TestTableEntry{
+	{
+		"standard", &survey.TreeSelect{
+			Message: "resource:",
+			Options: options(),
+		}, &resource, nil,
+	},
+	{
+		"default (starts on default/services/web)", &survey.TreeSelect{
+			Message: "resource:",
+			Options: options(),
+			Default: []string{"default", "services", "web"},
+		}, &resource, nil,
+	},
+	{
+		"filter (type proxy)", &survey.TreeSelect{
+			Message: "resource:",
+			Options: options(),
+		}, &resource, nil,
+	},
+	{
+		"paginated (move past the fourth node)", &survey.TreeSelect{
+			Message:  "resource:",
+			Options:  options(),
+			PageSize: 4,
+		}, &resource, nil,
+	},
+	{
+		"multiple", &survey.MultiTreeSelect{
+			Message: "resources:",
+			Options: options(),
+		}, &resources, nil,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(goodTable)
+}
@@ -0,0 +1,308 @@
+package survey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// TreeNode is an option of a TreeSelect or MultiTreeSelect that can hold more options.
+type TreeNode struct {
+	Value    string
+	Children []TreeNode
+	// Expanded shows the children of the node when the prompt starts
+	Expanded bool
+}
+
+// TreeRow is a node of the tree the way it is shown by the tree prompts
+type TreeRow struct {
+	Value string
+	// Index is the position of the node when every node is listed depth first
+	Index int
+	Depth int
+	// Guide is the indentation that connects the node to its parent
+	Guide       string
+	HasChildren bool
+	Expanded    bool
+}
+
+// treeItem is a node of the tree along with where it is in the tree
+type treeItem struct {
+	node   *TreeNode
+	parent int
+	depth  int
+	path   []string
+}
+
+// tree keeps track of the nodes of a tree prompt and which of them are expanded or focused
+type tree struct {
+	items    []treeItem
+	expanded map[int]bool
+	focus    int
+}
+
+// newTree lists the nodes depth first so that every node comes after its ancestors
+func newTree(roots []TreeNode) *tree {
+	t := &tree{expanded: map[int]bool{}}
+
+	var add func(nodes []TreeNode, parent int, path []string)
+	add = func(nodes []TreeNode, parent int, path []string) {
+		for i := range nodes {
+			node := &nodes[i]
+			nodePath := append(append([]string{}, path...), node.Value)
+
+			index := len(t.items)
+			t.items = append(t.items, treeItem{node: node, parent: parent, depth: len(path), path: nodePath})
+			if node.Expanded {
+				t.expanded[index] = true
+			}
+
+			add(node.Children, index, nodePath)
+		}
+	}
+	add(roots, -1, nil)
+
+	return t
+}
+
+// answer returns the answer for the node with the given index
+func (t *tree) answer(index int) core.TreeAnswer {
+	item := t.items[index]
+	return core.TreeAnswer{
+		Value: item.node.Value,
+		Path:  append([]string{}, item.path...),
+		Index: index,
+	}
+}
+
+// reveal expands the ancestors of a node so that it is shown
+func (t *tree) reveal(index int) {
+	for parent := t.items[index].parent; parent >= 0; parent = t.items[parent].parent {
+		t.expanded[parent] = true
+	}
+}
+
+// visible returns the indices of the nodes that are shown. Without a filter those are the nodes
+// whose ancestors are all expanded, otherwise the matching nodes and their ancestors are shown.
+func (t *tree) visible(filter string, match func(filter string, value string, index int) bool) []int {
+	shown := make([]bool, len(t.items))
+
+	for i, item := range t.items {
+		if filter == "" {
+			shown[i] = item.parent < 0 || (shown[item.parent] && t.expanded[item.parent])
+			continue
+		}
+
+		if match(filter, item.node.Value, i) {
+			// keep the ancestors of the match so the user knows where it is
+			for j := i; j >= 0 && !shown[j]; j = t.items[j].parent {
+				shown[j] = true
+			}
+		}
+	}
+
+	visible := []int{}
+	for i := range t.items {
+		if shown[i] {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
+
+// rows returns the visible nodes with the guides that connect them to their parents
+func (t *tree) rows(visible []int) []TreeRow {
+	// the last visible child of every parent
+	lastChild := map[int]int{}
+	for _, i := range visible {
+		lastChild[t.items[i].parent] = i
+	}
+	isLast := func(i int) bool {
+		return lastChild[t.items[i].parent] == i
+	}
+
+	rows := []TreeRow{}
+	for pos, i := range visible {
+		item := t.items[i]
+
+		guide := ""
+		if item.depth > 0 {
+			// the ancestors below the roots continue their line unless they are the last child
+			for a := item.parent; t.items[a].depth > 0; a = t.items[a].parent {
+				if isLast(a) {
+					guide = "   " + guide
+				} else {
+					guide = "│  " + guide
+				}
+			}
+			if isLast(i) {
+				guide += "└─ "
+			} else {
+				guide += "├─ "
+			}
+		}
+
+		rows = append(rows, TreeRow{
+			Value:       item.node.Value,
+			Index:       i,
+			Depth:       item.depth,
+			Guide:       guide,
+			HasChildren: len(item.node.Children) > 0,
+			// a node is shown as expanded when its children are listed below it
+			Expanded: pos+1 < len(visible) && t.items[visible[pos+1]].parent == i,
+		})
+	}
+	return rows
+}
+
+// position returns where the focused node is in the visible nodes, or the first one if the
+// focused node isn't visible.
+func (t *tree) position(visible []int) int {
+	for pos, i := range visible {
+		if i == t.focus {
+			return pos
+		}
+	}
+	if len(visible) > 0 {
+		t.focus = visible[0]
+	}
+	return 0
+}
+
+// move focuses the node the given number of rows away, wrapping around at the ends
+func (t *tree) move(visible []int, delta int) {
+	if len(visible) == 0 {
+		return
+	}
+	pos := (t.position(visible) + delta + len(visible)) % len(visible)
+	t.focus = visible[pos]
+}
+
+// expand shows the children of the focused node, or moves to its first child if they are shown
+func (t *tree) expand(visible []int, filtering bool) {
+	pos := t.position(visible)
+	if len(visible) == 0 {
+		return
+	}
+	item := t.items[t.focus]
+
+	if pos+1 < len(visible) && t.items[visible[pos+1]].parent == t.focus {
+		t.focus = visible[pos+1]
+	} else if len(item.node.Children) > 0 && !filtering {
+		t.expanded[t.focus] = true
+	}
+}
+
+// collapse hides the children of the focused node, or moves to its parent if they are hidden
+func (t *tree) collapse(visible []int, filtering bool) {
+	pos := t.position(visible)
+	if len(visible) == 0 {
+		return
+	}
+
+	if t.expanded[t.focus] && !filtering && pos+1 < len(visible) && t.items[visible[pos+1]].parent == t.focus {
+		t.expanded[t.focus] = false
+	} else if parent := t.items[t.focus].parent; parent >= 0 {
+		t.focus = parent
+	}
+}
+
+// page returns the rows of the page with the focused node and where the focused node is on it
+func (t *tree) page(pageSize int, rows []TreeRow, visible []int) ([]TreeRow, int) {
+	choices := []core.OptionAnswer{}
+	for pos, row := range rows {
+		choices = append(choices, core.OptionAnswer{Value: row.Value, Index: pos})
+	}
+
+	opts, idx := paginate(pageSize, choices, t.position(visible))
+
+	page := []TreeRow{}
+	for _, opt := range opts {
+		page = append(page, rows[opt.Index])
+	}
+	return page, idx
+}
+
+// find returns the index of the node with the given path
+func (t *tree) find(path []string) (int, error) {
+	for i, item := range t.items {
+		if len(item.path) != len(path) {
+			continue
+		}
+		match := true
+		for j := range path {
+			if item.path[j] != path[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%q is not in the tree", strings.Join(path, "/"))
+}
+
+// lookup returns the index of a node given either its path separated by slashes or, if only one
+// node has it, its value.
+func (t *tree) lookup(text string) (int, error) {
+	text = strings.TrimSpace(text)
+	if i, err := t.find(strings.Split(text, "/")); err == nil {
+		return i, nil
+	}
+
+	found := -1
+	for i, item := range t.items {
+		if item.node.Value != text {
+			continue
+		}
+		if found >= 0 {
+			return 0, fmt.Errorf("%q is in the tree more than once, use its path instead", text)
+		}
+		found = i
+	}
+	if found < 0 {
+		return 0, fmt.Errorf("%q is not in the tree", text)
+	}
+	return found, nil
+}
+
+// indexOf returns the index of the node described by a default or an answer. It accepts a
+// TreeAnswer, the path of the node or a string that lookup accepts.
+func (t *tree) indexOf(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case core.TreeAnswer:
+		return t.find(v.Path)
+	case []string:
+		return t.find(v)
+	case []interface{}:
+		path := []string{}
+		for _, segment := range v {
+			path = append(path, fmt.Sprint(segment))
+		}
+		return t.find(path)
+	case string:
+		return t.lookup(v)
+	}
+	return 0, fmt.Errorf("cannot use %T as a node of a tree", value)
+}
+
+// plainOptions lists every node of the tree, indented by its depth
+func (t *tree) plainOptions() []PlainOption {
+	options := []PlainOption{}
+	for i, item := range t.items {
+		options = append(options, PlainOption{Number: i + 1, Value: strings.Repeat("  ", item.depth) + item.node.Value})
+	}
+	return options
+}
+
+// parsePlain returns the node the user picked by typing either its number, its path or its value
+func (t *tree) parsePlain(input string) (int, error) {
+	input = strings.TrimSpace(input)
+	if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(t.items) {
+		return n - 1, nil
+	}
+	return t.lookup(input)
+}
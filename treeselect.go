@@ -0,0 +1,279 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+/*
+TreeSelect is a prompt that presents a tree of options to the user for them to select using
+the arrow keys and enter. The right arrow expands a node and the left arrow collapses it, and
+typing filters the nodes while keeping the ancestors of the matches visible. Response type is a
+core.TreeAnswer with the value and the path of the node. Default is the path of the node that
+is focused when the prompt starts.
+
+	resource := survey.TreeAnswer{}
+	prompt := &survey.TreeSelect{
+		Message: "Choose a resource:",
+		Options: []survey.TreeNode{
+			{Value: "default", Children: []survey.TreeNode{
+				{Value: "deployments", Children: []survey.TreeNode{{Value: "web"}, {Value: "worker"}}},
+				{Value: "services", Children: []survey.TreeNode{{Value: "web"}}},
+			}},
+		},
+	}
+	survey.AskOne(prompt, &resource)
+*/
+type TreeSelect struct {
+	Renderer
+	Message       string
+	Options       []TreeNode
+	Default       []string
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	Filter        func(filter string, value string, index int) bool
+	tree          *tree
+	filter        string
+	showingHelp   bool
+}
+
+// TreeSelectTemplateData is the data available to the templates when processing
+type TreeSelectTemplateData struct {
+	TreeSelect
+	PageEntries   []TreeRow
+	SelectedIndex int
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+	Config        *PromptConfig
+}
+
+var TreeSelectQuestionTemplate = `
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move, right to expand, left to collapse, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- if eq $.SelectedIndex $ix }}{{color $.Config.Icons.SelectFocus.Format }}{{ $.Config.Icons.SelectFocus.Text }} {{else}}{{color "default"}}  {{end}}
+    {{- $row.Guide}}{{if $row.Expanded}}▾ {{else if $row.HasChildren}}▸ {{end}}{{ $row.Value }}
+    {{- color "reset"}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// match returns the filter used to find the nodes the user is looking for
+func (s *TreeSelect) match(config *PromptConfig) func(filter string, value string, index int) bool {
+	if s.Filter != nil {
+		return s.Filter
+	}
+	return config.Filter
+}
+
+// OnChange is called on every keypress.
+func (s *TreeSelect) OnChange(key rune, config *PromptConfig) bool {
+	visible := s.tree.visible(s.filter, s.match(config))
+	filtering := s.filter != ""
+
+	if key == terminal.KeyEnter || key == '\n' {
+		// we're done if there is a node to pick
+		return len(visible) > 0
+	} else if key == terminal.KeyArrowUp || (s.VimMode && key == 'k') {
+		s.tree.move(visible, -1)
+	} else if key == terminal.KeyTab || key == terminal.KeyArrowDown || (s.VimMode && key == 'j') {
+		s.tree.move(visible, 1)
+	} else if key == terminal.KeyArrowRight || (s.VimMode && key == 'l') {
+		s.tree.expand(visible, filtering)
+	} else if key == terminal.KeyArrowLeft || (s.VimMode && key == 'h') {
+		s.tree.collapse(visible, filtering)
+	} else if string(key) == config.HelpInput && s.Help != "" {
+		s.showingHelp = true
+	} else if key == terminal.KeyEscape {
+		s.VimMode = !s.VimMode
+	} else if key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine {
+		s.filter = ""
+	} else if key == terminal.KeyDelete || key == terminal.KeyBackspace {
+		if s.filter != "" {
+			runeFilter := []rune(s.filter)
+			s.filter = string(runeFilter[0 : len(runeFilter)-1])
+		}
+	} else if terminal.IsTyped(key) {
+		s.filter += string(key)
+		s.VimMode = false
+	}
+
+	s.FilterMessage = ""
+	if s.filter != "" {
+		s.FilterMessage = " " + s.filter
+	}
+
+	_ = s.render(config)
+
+	// keep prompting
+	return false
+}
+
+// render shows the page of the tree with the focused node
+func (s *TreeSelect) render(config *PromptConfig) error {
+	pageSize := s.PageSize
+	if pageSize == 0 {
+		pageSize = config.PageSize
+	}
+
+	visible := s.tree.visible(s.filter, s.match(config))
+	rows, idx := s.tree.page(pageSize, s.tree.rows(visible), visible)
+
+	return s.Render(TreeSelectQuestionTemplate, TreeSelectTemplateData{
+		TreeSelect:    *s,
+		PageEntries:   rows,
+		SelectedIndex: idx,
+		ShowHelp:      s.showingHelp,
+		Config:        config,
+	})
+}
+
+// start builds the tree and focuses the default node
+func (s *TreeSelect) start() error {
+	if len(s.Options) == 0 {
+		return errors.New("please provide options to select from")
+	}
+
+	s.tree = newTree(s.Options)
+	if len(s.Default) > 0 {
+		index, err := s.tree.find(s.Default)
+		if err != nil {
+			return fmt.Errorf("default value %w", err)
+		}
+		s.tree.focus = index
+		s.tree.reveal(index)
+	}
+	return nil
+}
+
+func (s *TreeSelect) Prompt(config *PromptConfig) (interface{}, error) {
+	if err := s.start(); err != nil {
+		return core.TreeAnswer{}, err
+	}
+
+	if !s.interactive() {
+		return s.promptPlain(config)
+	}
+
+	cursor := s.NewCursor()
+	cursor.Hide()       // hide the cursor
+	defer cursor.Show() // show the cursor when we're done
+
+	// ask the question
+	if err := s.render(config); err != nil {
+		return core.TreeAnswer{}, err
+	}
+
+	rr := s.NewRuneReader()
+	_ = rr.SetTermMode()
+	defer func() {
+		_ = rr.RestoreTermMode()
+	}()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return core.TreeAnswer{}, err
+		}
+		if r == terminal.KeyInterrupt {
+			return core.TreeAnswer{}, terminal.InterruptErr
+		}
+		if config.BackKey != 0 && r == config.BackKey {
+			s.filter = ""
+			s.FilterMessage = ""
+			return core.TreeAnswer{}, ErrGoBack
+		}
+		if r == terminal.KeyEndTransmission {
+			break
+		}
+		if s.OnChange(r, config) {
+			break
+		}
+	}
+
+	// make sure the focused node is one of the visible ones
+	s.tree.position(s.tree.visible(s.filter, s.match(config)))
+	s.filter = ""
+	s.FilterMessage = ""
+
+	return s.tree.answer(s.tree.focus), nil
+}
+
+// promptPlain lists the numbered nodes and reads a line with the number, the path or the value
+// of a node.
+func (s *TreeSelect) promptPlain(config *PromptConfig) (interface{}, error) {
+	return s.askPlain(config, PlainTemplateData{
+		Message:      s.Message,
+		Help:         s.Help,
+		Options:      s.tree.plainOptions(),
+		Instructions: "Enter a number or path",
+		Default:      s.tree.answer(s.tree.focus).String(),
+	}, func(line string) (interface{}, error) {
+		if strings.TrimSpace(line) == "" {
+			return s.tree.answer(s.tree.focus), nil
+		}
+
+		index, err := s.tree.parsePlain(line)
+		if err != nil {
+			return nil, err
+		}
+		return s.tree.answer(index), nil
+	})
+}
+
+// parseAnswer picks the node given by an AnswerSource.
+func (s *TreeSelect) parseAnswer(value interface{}) (interface{}, error) {
+	t := newTree(s.Options)
+	index, err := t.indexOf(value)
+	if err != nil {
+		return nil, err
+	}
+	return t.answer(index), nil
+}
+
+// defaultAnswer returns the node the cursor starts on.
+func (s *TreeSelect) defaultAnswer() (interface{}, error) {
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+	return s.tree.answer(s.tree.focus), nil
+}
+
+// SetDefault uses the given node as the default selection. It accepts a TreeAnswer, the path of
+// a node, or a string with either the path separated by slashes or the value of a node.
+func (s *TreeSelect) SetDefault(value interface{}) error {
+	t := newTree(s.Options)
+	index, err := t.indexOf(value)
+	if err != nil {
+		return err
+	}
+	s.Default = t.answer(index).Path
+	return nil
+}
+
+func (s *TreeSelect) Cleanup(config *PromptConfig, val interface{}) error {
+	if !s.interactive() {
+		return nil
+	}
+	return s.Render(
+		TreeSelectQuestionTemplate,
+		TreeSelectTemplateData{
+			TreeSelect: *s,
+			Answer:     val.(core.TreeAnswer).String(),
+			ShowAnswer: true,
+			Config:     config,
+		},
+	)
+}
@@ -0,0 +1,319 @@
+package survey
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// resourceTree returns a tree of kubernetes resources to pick from
+func resourceTree() []TreeNode {
+	return []TreeNode{
+		{Value: "default", Children: []TreeNode{
+			{Value: "deployments", Children: []TreeNode{{Value: "web"}, {Value: "worker"}}},
+			{Value: "services", Children: []TreeNode{{Value: "web"}}},
+		}},
+		{Value: "kube-system", Children: []TreeNode{
+			{Value: "pods", Children: []TreeNode{{Value: "coredns"}}},
+		}},
+	}
+}
+
+// treeLines returns how the visible nodes are shown, without the markers
+func treeLines(rows []TreeRow) []string {
+	lines := []string{}
+	for _, row := range rows {
+		lines = append(lines, row.Guide+row.Value)
+	}
+	return lines
+}
+
+func TestTreeVisible(t *testing.T) {
+	tr := newTree(resourceTree())
+
+	// only the roots are shown until they are expanded
+	assert.Equal(t, []string{"default", "kube-system"}, treeLines(tr.rows(tr.visible("", nil))))
+
+	for i := range tr.items {
+		tr.expanded[i] = true
+	}
+	assert.Equal(t, []string{
+		"default",
+		"├─ deployments",
+		"│  ├─ web",
+		"│  └─ worker",
+		"└─ services",
+		"   └─ web",
+		"kube-system",
+		"└─ pods",
+		"   └─ coredns",
+	}, treeLines(tr.rows(tr.visible("", nil))))
+
+	// collapsing a node hides all of its descendants
+	tr.expanded[1] = false
+	rows := tr.rows(tr.visible("", nil))
+	assert.Equal(t, []string{
+		"default",
+		"├─ deployments",
+		"└─ services",
+		"   └─ web",
+		"kube-system",
+		"└─ pods",
+		"   └─ coredns",
+	}, treeLines(rows))
+	assert.True(t, rows[1].HasChildren)
+	assert.False(t, rows[1].Expanded)
+	assert.True(t, rows[2].Expanded)
+}
+
+func TestTreeVisible_Filter(t *testing.T) {
+	tr := newTree(resourceTree())
+	match := defaultPromptConfig().Filter
+
+	// the ancestors of the matches are shown even though nothing is expanded
+	assert.Equal(t, []string{
+		"default",
+		"├─ deployments",
+		"│  └─ web",
+		"└─ services",
+		"   └─ web",
+	}, treeLines(tr.rows(tr.visible("we", match))))
+
+	assert.Equal(t, []string{
+		"kube-system",
+		"└─ pods",
+		"   └─ coredns",
+	}, treeLines(tr.rows(tr.visible("core", match))))
+
+	assert.Empty(t, tr.visible("nothing", match))
+}
+
+func TestTreeLookup(t *testing.T) {
+	tr := newTree(resourceTree())
+
+	index, err := tr.lookup("default/services/web")
+	assert.NoError(t, err)
+	assert.Equal(t, core.TreeAnswer{Value: "web", Path: []string{"default", "services", "web"}, Index: 5}, tr.answer(index))
+
+	index, err = tr.lookup("coredns")
+	assert.NoError(t, err)
+	assert.Equal(t, 8, index)
+
+	_, err = tr.lookup("web")
+	assert.EqualError(t, err, `"web" is in the tree more than once, use its path instead`)
+
+	_, err = tr.lookup("default/pods")
+	assert.EqualError(t, err, `"default/pods" is not in the tree`)
+}
+
+func TestTreeSelectRender(t *testing.T) {
+	prompt := TreeSelect{
+		Message: "Choose a resource:",
+		Options: resourceTree(),
+	}
+
+	tr := newTree(prompt.Options)
+	tr.expanded[0] = true
+	tr.expanded[1] = true
+	rows := tr.rows(tr.visible("", nil))
+
+	helpfulPrompt := prompt
+	helpfulPrompt.Help = "This is helpful"
+
+	tests := []struct {
+		title    string
+		prompt   TreeSelect
+		data     TreeSelectTemplateData
+		expected string
+	}{
+		{
+			"Test TreeSelect question output",
+			prompt,
+			TreeSelectTemplateData{SelectedIndex: 2, PageEntries: rows},
+			strings.Join(
+				[]string{
+					fmt.Sprintf("%s Choose a resource:  [Use arrows to move, right to expand, left to collapse, type to filter]", defaultIcons().Question.Text),
+					"  ▾ default",
+					"  ├─ ▾ deployments",
+					fmt.Sprintf("%s │  ├─ web", defaultIcons().SelectFocus.Text),
+					"  │  └─ worker",
+					"  └─ ▸ services",
+					"  ▸ kube-system\n",
+				},
+				"\n",
+			),
+		},
+		{
+			"Test TreeSelect answer output",
+			prompt,
+			TreeSelectTemplateData{Answer: "default/deployments/web", ShowAnswer: true, PageEntries: rows},
+			fmt.Sprintf("%s Choose a resource: default/deployments/web\n", defaultIcons().Question.Text),
+		},
+		{
+			"Test TreeSelect question output with help hidden",
+			helpfulPrompt,
+			TreeSelectTemplateData{PageEntries: rows[:1]},
+			fmt.Sprintf("%s Choose a resource:  [Use arrows to move, right to expand, left to collapse, type to filter, %s for more help]\n%s ▾ default\n", defaultIcons().Question.Text, defaultPromptConfig().HelpInput, defaultIcons().SelectFocus.Text),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			r, w, err := os.Pipe()
+			assert.NoError(t, err)
+
+			test.prompt.WithStdio(terminal.Stdio{Out: w})
+			test.data.TreeSelect = test.prompt
+
+			// set the icon set
+			test.data.Config = defaultPromptConfig()
+
+			err = test.prompt.Render(
+				TreeSelectQuestionTemplate,
+				test.data,
+			)
+			assert.NoError(t, err)
+
+			assert.NoError(t, w.Close())
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			assert.NoError(t, err)
+
+			assert.Contains(t, buf.String(), test.expected)
+		})
+	}
+}
+
+func TestTreeSelectPrompt(t *testing.T) {
+	tests := []PromptTest{
+		{
+			"basic interaction",
+			&TreeSelect{
+				Message: "Choose a resource:",
+				Options: resourceTree(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a resource:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "default", Path: []string{"default"}, Index: 0},
+		},
+		{
+			"expand nodes with the right arrow",
+			&TreeSelect{
+				Message: "Choose a resource:",
+				Options: resourceTree(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a resource:")
+				// expand default and move into it
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowRight))
+				// expand deployments and pick worker
+				c.Send(string(terminal.KeyArrowRight))
+				c.Send(string(terminal.KeyArrowDown))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "worker", Path: []string{"default", "deployments", "worker"}, Index: 3},
+		},
+		{
+			"collapse nodes with the left arrow",
+			&TreeSelect{
+				Message: "Choose a resource:",
+				Options: resourceTree(),
+				Default: []string{"default", "deployments", "worker"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a resource:")
+				// move to deployments, collapse it and move past it
+				c.Send(string(terminal.KeyArrowLeft))
+				c.Send(string(terminal.KeyArrowLeft))
+				c.Send(string(terminal.KeyArrowDown))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "services", Path: []string{"default", "services"}, Index: 4},
+		},
+		{
+			"default value",
+			&TreeSelect{
+				Message: "Choose a resource:",
+				Options: resourceTree(),
+				Default: []string{"default", "services", "web"},
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a resource:")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "web", Path: []string{"default", "services", "web"}, Index: 5},
+		},
+		{
+			"filter keeps the ancestors of the matches",
+			&TreeSelect{
+				Message: "Choose a resource:",
+				Options: resourceTree(),
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a resource:")
+				c.Send("core")
+				c.ExpectString("coredns")
+				c.Send(string(terminal.KeyArrowUp))
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "coredns", Path: []string{"kube-system", "pods", "coredns"}, Index: 8},
+		},
+		{
+			"prompt for help",
+			&TreeSelect{
+				Message: "Choose a resource:",
+				Options: resourceTree(),
+				Help:    "The resource to describe",
+			},
+			func(c expectConsole) {
+				c.ExpectString("Choose a resource:")
+				c.Send("?")
+				c.ExpectString("The resource to describe")
+				c.SendLine("")
+				c.ExpectEOF()
+			},
+			core.TreeAnswer{Value: "default", Path: []string{"default"}, Index: 0},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			RunPromptTest(t, test)
+		})
+	}
+}
+
+func TestTreeSelectSetDefault(t *testing.T) {
+	prompt := &TreeSelect{Options: resourceTree()}
+
+	assert.NoError(t, prompt.SetDefault(core.TreeAnswer{Path: []string{"default", "services"}}))
+	assert.Equal(t, []string{"default", "services"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault("coredns"))
+	assert.Equal(t, []string{"kube-system", "pods", "coredns"}, prompt.Default)
+
+	assert.NoError(t, prompt.SetDefault([]string{"default"}))
+	assert.Equal(t, []string{"default"}, prompt.Default)
+
+	assert.Error(t, prompt.SetDefault("missing"))
+	assert.Error(t, prompt.SetDefault(3))
+	assert.Equal(t, []string{"default"}, prompt.Default)
+}